@@ -26,6 +26,7 @@ type AccountHandler struct {
 // GetBalance returns account balance information
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Account/operation/GetCurrentAccountBalance
 func (h *AccountHandler) GetBalance(ctx context.Context) (*AccountBalance, error) {
+	ctx = withOperation(ctx, "Account.GetBalance", accountBalancePath)
 	url := h.client.baseURL + accountBalancePath
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
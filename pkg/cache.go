@@ -0,0 +1,267 @@
+package serverscom
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheEntry represents a cached response to a GET request
+type CacheEntry struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+
+	// ExpiresAt is a time until which the entry is used without revalidation
+	ExpiresAt time.Time
+}
+
+// Cache is an interface for storing responses to GET requests, keys are full request URLs.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+// MemoryCache is an in-memory Cache
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]*CacheEntry
+}
+
+// NewMemoryCache builds a new empty in-memory cache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]*CacheEntry),
+	}
+}
+
+// Get implements Cache
+func (c *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+
+	return entry, ok
+}
+
+// Set implements Cache
+func (c *MemoryCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = entry
+}
+
+// Delete implements Cache
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// WithCache enables caching of GET responses.
+//
+// A cached response is used without a request while it's fresh according to Cache-Control max-age
+// or a TTL set by WithCacheTTL, after that it's revalidated with If-None-Match and If-Modified-Since
+// headers built from ETag and Last-Modified. Responses with Cache-Control no-store are never cached,
+// successful POST, PUT and DELETE requests invalidate a cached response of the same URL.
+//
+// A response served from the cache without a request isn't observed by MetricsRecorder, its span
+// has the serverscom.cache attribute set to hit.
+//
+// Entries are keyed by URL, so a cache must not be shared by clients of different accounts.
+func WithCache(cache Cache) Option {
+	return func(o *clientOptions) {
+		o.cache = cache
+	}
+}
+
+// WithCacheTTL overrides freshness lifetime of cached responses of a service method, e.g. Locations.ServerModelOptions
+// or CloudComputingRegions.Images. It's used only with WithCache, zero TTL means a response is always revalidated.
+func WithCacheTTL(operation string, ttl time.Duration) Option {
+	return func(o *clientOptions) {
+		if o.cacheTTLs == nil {
+			o.cacheTTLs = make(map[string]time.Duration)
+		}
+
+		o.cacheTTLs[operation] = ttl
+	}
+}
+
+type httpCache struct {
+	cache Cache
+	ttls  map[string]time.Duration
+}
+
+// execWithCache serves GET requests from the cache when it's enabled, hit reports whether the response
+// was served without a request. Successful requests with other methods invalidate a cached response of the same URL.
+func (cli *Client) execWithCache(ctx context.Context, method, endpointURL string, body []byte) (resp *Response, hit bool, err error) {
+	if cli.cache.cache == nil {
+		resp, err = cli.execWithRetries(ctx, method, endpointURL, body, nil)
+
+		return resp, false, err
+	}
+
+	if method != http.MethodGet {
+		resp, err = cli.execWithRetries(ctx, method, endpointURL, body, nil)
+		if err == nil && resp.StatusCode < 400 {
+			cli.cache.cache.Delete(endpointURL)
+		}
+
+		return resp, false, err
+	}
+
+	entry, cached := cli.cache.cache.Get(endpointURL)
+
+	if cached && time.Now().Before(entry.ExpiresAt) {
+		return entry.response(), true, nil
+	}
+
+	var header http.Header
+
+	if cached {
+		header = entry.conditionalHeader()
+	}
+
+	resp, err = cli.execWithRetries(ctx, method, endpointURL, body, header)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if cached && resp.StatusCode == http.StatusNotModified {
+		header := entry.updatedHeader(resp.Header)
+
+		entry = &CacheEntry{
+			StatusCode: entry.StatusCode,
+			Header:     header,
+			Body:       entry.Body,
+			ExpiresAt:  cli.cache.expiresAt(ctx, header),
+		}
+
+		cli.cache.cache.Set(endpointURL, entry)
+
+		return entry.response(), false, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, false, nil
+	}
+
+	entry = &CacheEntry{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       bytes.Clone(resp.Body),
+		ExpiresAt:  cli.cache.expiresAt(ctx, resp.Header),
+	}
+
+	if cacheControlDirectives(resp.Header)["no-store"] != "" || !entry.reusable() {
+		cli.cache.cache.Delete(endpointURL)
+
+		return resp, false, nil
+	}
+
+	cli.cache.cache.Set(endpointURL, entry)
+
+	return resp, false, nil
+}
+
+// expiresAt returns a time until which a response is fresh, the TTL of the operation takes precedence over Cache-Control
+func (c httpCache) expiresAt(ctx context.Context, header http.Header) time.Time {
+	now := time.Now()
+
+	op, _ := operationFromContext(ctx)
+
+	if ttl, ok := c.ttls[op.Name]; ok {
+		return now.Add(ttl)
+	}
+
+	directives := cacheControlDirectives(header)
+
+	if directives["no-cache"] != "" {
+		return now
+	}
+
+	if maxAge, err := strconv.Atoi(directives["max-age"]); err == nil && maxAge > 0 {
+		return now.Add(time.Duration(maxAge) * time.Second)
+	}
+
+	return now
+}
+
+// response returns a copy of the cached response, so callers can't modify the entry
+func (e *CacheEntry) response() *Response {
+	return &Response{
+		StatusCode: e.StatusCode,
+		Header:     e.Header.Clone(),
+		Body:       bytes.Clone(e.Body),
+	}
+}
+
+// updatedHeader returns a copy of the entry header updated with headers of a 304 response,
+// as described in RFC 9111 section 4.3.4, e.g. a new ETag, Cache-Control or Date
+func (e *CacheEntry) updatedHeader(notModified http.Header) http.Header {
+	header := e.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	for key, values := range notModified {
+		switch http.CanonicalHeaderKey(key) {
+		case "Content-Length", "Connection", "Keep-Alive", "Transfer-Encoding":
+			continue
+		}
+
+		header[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+	}
+
+	return header
+}
+
+// reusable reports whether the entry is fresh or can be revalidated
+func (e *CacheEntry) reusable() bool {
+	return time.Now().Before(e.ExpiresAt) || e.Header.Get("ETag") != "" || e.Header.Get("Last-Modified") != ""
+}
+
+func (e *CacheEntry) conditionalHeader() http.Header {
+	header := make(http.Header)
+
+	if etag := e.Header.Get("ETag"); etag != "" {
+		header.Set("If-None-Match", etag)
+	}
+
+	if lastModified := e.Header.Get("Last-Modified"); lastModified != "" {
+		header.Set("If-Modified-Since", lastModified)
+	}
+
+	return header
+}
+
+// cacheControlDirectives parses Cache-Control header, directives without a value are set to "true"
+func cacheControlDirectives(header http.Header) map[string]string {
+	directives := make(map[string]string)
+
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			name, arg, found := strings.Cut(strings.TrimSpace(directive), "=")
+			if name == "" {
+				continue
+			}
+
+			if !found {
+				arg = "true"
+			}
+
+			directives[strings.ToLower(name)] = strings.Trim(arg, `"`)
+		}
+	}
+
+	return directives
+}
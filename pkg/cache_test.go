@@ -0,0 +1,333 @@
+package serverscom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	. "github.com/onsi/gomega"
+)
+
+type cacheTestServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func newCacheTestServer(handler func(w http.ResponseWriter, r *http.Request)) *cacheTestServer {
+	ts := &cacheTestServer{}
+
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ts.mu.Lock()
+		ts.requests = append(ts.requests, r)
+		ts.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r)
+	}))
+
+	return ts
+}
+
+func (ts *cacheTestServer) Requests() []*http.Request {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	return append([]*http.Request(nil), ts.requests...)
+}
+
+func TestCacheRevalidatesWithETag(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts := newCacheTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Write([]byte(`[{"id": 1, "name": "model"}]`))
+	})
+
+	defer ts.Close()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL+"/v1"), WithCache(NewMemoryCache()))
+
+	ctx := context.TODO()
+
+	for i := 0; i < 2; i++ {
+		options, err := client.Locations.ServerModelOptions(1).Collect(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(options).To(HaveLen(1))
+		g.Expect(options[0].Name).To(Equal("model"))
+	}
+
+	requests := ts.Requests()
+	g.Expect(requests).To(HaveLen(2))
+	g.Expect(requests[0].Header.Get("If-None-Match")).To(BeEmpty())
+	g.Expect(requests[1].Header.Get("If-None-Match")).To(Equal(`"v1"`))
+}
+
+func TestCacheUpdatesHeadersOnRevalidation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts := newCacheTestServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("If-None-Match") {
+		case `"v1"`:
+			w.Header().Set("ETag", `"v2"`)
+			w.Header().Set("Cache-Control", "max-age=3600")
+			w.WriteHeader(http.StatusNotModified)
+		case `"v2"`:
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.Header().Set("ETag", `"v1"`)
+			w.Write([]byte(`[{"id": 1, "name": "model"}]`))
+		}
+	})
+
+	defer ts.Close()
+
+	cache := NewMemoryCache()
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL+"/v1"), WithCache(cache))
+
+	ctx := context.TODO()
+
+	for i := 0; i < 3; i++ {
+		options, err := client.Locations.ServerModelOptions(1).Collect(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(options).To(HaveLen(1))
+	}
+
+	g.Expect(ts.Requests()).To(HaveLen(2))
+
+	endpointURL := ts.URL + ts.Requests()[0].URL.String()
+
+	entry, ok := cache.Get(endpointURL)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(entry.Header.Get("ETag")).To(Equal(`"v2"`))
+	g.Expect(entry.Header.Get("Cache-Control")).To(Equal("max-age=3600"))
+	g.Expect(string(entry.Body)).To(Equal(`[{"id": 1, "name": "model"}]`))
+
+	expired := *entry
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+	cache.Set(endpointURL, &expired)
+
+	_, err := client.Locations.ServerModelOptions(1).Collect(ctx)
+	g.Expect(err).To(BeNil())
+
+	requests := ts.Requests()
+	g.Expect(requests).To(HaveLen(3))
+	g.Expect(requests[2].Header.Get("If-None-Match")).To(Equal(`"v2"`))
+}
+
+func TestCacheHonorsMaxAgeAndLastModified(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts := newCacheTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "private, max-age=3600")
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		w.Write([]byte(`[{"id": "1", "name": "image"}]`))
+	})
+
+	defer ts.Close()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL+"/v1"), WithCache(NewMemoryCache()))
+
+	ctx := context.TODO()
+
+	for i := 0; i < 3; i++ {
+		images, err := client.CloudComputingRegions.Images(1).Collect(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(images).To(HaveLen(1))
+	}
+
+	g.Expect(ts.Requests()).To(HaveLen(1))
+
+	cache := NewMemoryCache()
+	client = NewClientWithOptions("token", WithBaseURL(ts.URL+"/v1"), WithCache(cache), WithCacheTTL("CloudComputingRegions.Images", 0))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.CloudComputingRegions.Images(1).Collect(ctx)
+		g.Expect(err).To(BeNil())
+	}
+
+	requests := ts.Requests()
+	g.Expect(requests).To(HaveLen(3))
+	g.Expect(requests[2].Header.Get("If-Modified-Since")).To(Equal("Wed, 21 Oct 2015 07:28:00 GMT"))
+}
+
+func TestCacheTTLOverride(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts := newCacheTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": "1", "name": "flavor"}]`))
+	})
+
+	defer ts.Close()
+
+	client := NewClientWithOptions(
+		"token",
+		WithBaseURL(ts.URL+"/v1"),
+		WithCache(NewMemoryCache()),
+		WithCacheTTL("CloudComputingRegions.Flavors", time.Hour),
+	)
+
+	ctx := context.TODO()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.CloudComputingRegions.Flavors(1).Collect(ctx)
+		g.Expect(err).To(BeNil())
+
+		_, err = client.CloudComputingRegions.Images(1).Collect(ctx)
+		g.Expect(err).To(BeNil())
+	}
+
+	g.Expect(ts.Requests()).To(HaveLen(3))
+}
+
+func TestCacheSkipsNoStoreAndMutatingRequests(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts := newCacheTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("ETag", `"v1"`)
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Write([]byte(`{"id": "a", "name": "key", "fingerprint": "fp"}`))
+	})
+
+	defer ts.Close()
+
+	cache := NewMemoryCache()
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL+"/v1"), WithCache(cache))
+
+	ctx := context.TODO()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.SSHKeys.Get(ctx, "fp")
+		g.Expect(err).To(BeNil())
+
+		g.Expect(client.SSHKeys.Delete(ctx, "fp")).To(Succeed())
+	}
+
+	requests := ts.Requests()
+	g.Expect(requests).To(HaveLen(4))
+	g.Expect(requests[2].Header.Get("If-None-Match")).To(BeEmpty())
+
+	_, cached := cache.Get(ts.URL + "/v1/ssh_keys/fp")
+	g.Expect(cached).To(BeFalse())
+}
+
+func TestCacheInvalidatedByMutatingRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts := newCacheTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=3600")
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Write([]byte(`{"id": "a", "name": "key", "fingerprint": "fp"}`))
+	})
+
+	defer ts.Close()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL+"/v1"), WithCache(NewMemoryCache()))
+
+	ctx := context.TODO()
+
+	_, err := client.SSHKeys.Get(ctx, "fp")
+	g.Expect(err).To(BeNil())
+
+	_, err = client.SSHKeys.Get(ctx, "fp")
+	g.Expect(err).To(BeNil())
+
+	g.Expect(client.SSHKeys.Delete(ctx, "fp")).To(Succeed())
+
+	_, err = client.SSHKeys.Get(ctx, "fp")
+	g.Expect(err).To(BeNil())
+
+	g.Expect(ts.Requests()).To(HaveLen(3))
+}
+
+func TestCacheHitReturnsCopyOfEntry(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts := newCacheTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=3600")
+		w.Write([]byte(`[{"id": "1", "name": "image"}]`))
+	})
+
+	defer ts.Close()
+
+	cache := NewMemoryCache()
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL+"/v1"), WithCache(cache))
+
+	ctx := context.TODO()
+	endpointURL := ts.URL + "/v1/cloud_computing/regions/1/images"
+
+	for i := 0; i < 2; i++ {
+		resp, _, err := client.execWithCache(ctx, http.MethodGet, endpointURL, nil)
+		g.Expect(err).To(BeNil())
+
+		resp.Header.Set("Cache-Control", "no-store")
+		resp.Body[0] = '{'
+	}
+
+	g.Expect(ts.Requests()).To(HaveLen(1))
+
+	entry, ok := cache.Get(endpointURL)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(entry.Header.Get("Cache-Control")).To(Equal("max-age=3600"))
+	g.Expect(string(entry.Body)).To(Equal(`[{"id": "1", "name": "image"}]`))
+}
+
+func TestCacheHitIsNotObservedAsRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts := newCacheTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=3600")
+		w.Write([]byte(`[{"id": "1", "name": "image"}]`))
+	})
+
+	defer ts.Close()
+
+	recorder := &fakeMetricsRecorder{}
+	provider, exporter := newTestTracerProvider()
+
+	client := NewClientWithOptions(
+		"token",
+		WithBaseURL(ts.URL+"/v1"),
+		WithCache(NewMemoryCache()),
+		WithMetrics(recorder),
+		WithTracerProvider(provider),
+	)
+
+	ctx := context.TODO()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.CloudComputingRegions.Images(1).List(ctx)
+		g.Expect(err).To(BeNil())
+	}
+
+	g.Expect(ts.Requests()).To(HaveLen(1))
+	g.Expect(recorder.requests).To(HaveLen(1))
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(2))
+	g.Expect(spanAttributes(spans[0])).NotTo(HaveKey(attribute.Key("serverscom.cache")))
+	g.Expect(spanAttributes(spans[1])["serverscom.cache"].AsString()).To(Equal("hit"))
+}
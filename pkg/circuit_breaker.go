@@ -0,0 +1,198 @@
+package serverscom
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CircuitState represents a state of the circuit breaker
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen fails all requests fast with CircuitOpenError
+	CircuitOpen
+
+	// CircuitHalfOpen lets a single probe request through, other requests fail fast
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+// CircuitBreakerSettings describes when the circuit breaker trips and recovers
+type CircuitBreakerSettings struct {
+	// FailureThreshold is a number of consecutive 5xx responses or transport errors which trips the breaker,
+	// by default: 5
+	FailureThreshold int
+
+	// OpenTimeout is a time the breaker stays open before letting a probe through, by default: 30 seconds
+	OpenTimeout time.Duration
+
+	// OnStateChange is called after each state change
+	OnStateChange func(from, to CircuitState)
+}
+
+// CircuitOpenError is returned without sending a request while the circuit breaker is open
+type CircuitOpenError struct {
+	// RetryAfter is a time left until the breaker lets a probe through, it's zero while a probe is in flight
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("Circuit breaker is open, retry after: %s", e.RetryAfter)
+}
+
+// WithCircuitBreaker enables the circuit breaker, which fails requests fast with CircuitOpenError
+// after consecutive 5xx responses or transport errors.
+//
+// The breaker is checked before each attempt, so retries of a failing request count as failures too.
+func WithCircuitBreaker(settings CircuitBreakerSettings) Option {
+	return func(o *clientOptions) {
+		o.circuitBreaker = &settings
+	}
+}
+
+type circuitBreaker struct {
+	settings CircuitBreakerSettings
+
+	mu            sync.Mutex
+	state         CircuitState
+	failures      int
+	openedAt      time.Time
+	probeInFlight bool
+
+	// probe is a number of the last probe let through in the half-open state,
+	// results of other requests don't change the state until the breaker closes
+	probe uint64
+}
+
+func newCircuitBreaker(settings *CircuitBreakerSettings) *circuitBreaker {
+	if settings == nil {
+		return nil
+	}
+
+	cb := &circuitBreaker{settings: *settings}
+
+	if cb.settings.FailureThreshold <= 0 {
+		cb.settings.FailureThreshold = 5
+	}
+
+	if cb.settings.OpenTimeout <= 0 {
+		cb.settings.OpenTimeout = 30 * time.Second
+	}
+
+	return cb
+}
+
+// allow reports whether a request can be sent, it returns CircuitOpenError otherwise.
+// The returned number identifies a probe and must be passed to record, it's 0 for other requests.
+func (cb *circuitBreaker) allow() (uint64, error) {
+	if cb == nil {
+		return 0, nil
+	}
+
+	cb.mu.Lock()
+
+	from := cb.state
+
+	switch cb.state {
+	case CircuitOpen:
+		left := cb.settings.OpenTimeout - time.Since(cb.openedAt)
+		if left > 0 {
+			cb.mu.Unlock()
+
+			return 0, &CircuitOpenError{RetryAfter: left}
+		}
+
+		cb.state = CircuitHalfOpen
+		cb.probeInFlight = true
+		cb.probe++
+	case CircuitHalfOpen:
+		if cb.probeInFlight {
+			cb.mu.Unlock()
+
+			return 0, &CircuitOpenError{}
+		}
+
+		cb.probeInFlight = true
+		cb.probe++
+	}
+
+	var probe uint64
+	if cb.state == CircuitHalfOpen {
+		probe = cb.probe
+	}
+
+	to := cb.state
+	cb.mu.Unlock()
+
+	cb.notify(from, to)
+
+	return probe, nil
+}
+
+// record updates the breaker with a result of a request let through by allow with the probe
+// number returned by allow, requests cancelled by the caller aren't counted.
+//
+// While the breaker is open or half-open only the result of the current probe changes the state,
+// results of requests sent before the breaker tripped are ignored.
+func (cb *circuitBreaker) record(ctx context.Context, probe uint64, resp *Response, err error) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+
+	if cb.state != CircuitClosed {
+		if probe == 0 || probe != cb.probe || !cb.probeInFlight {
+			cb.mu.Unlock()
+
+			return
+		}
+
+		cb.probeInFlight = false
+	}
+
+	from := cb.state
+
+	switch {
+	case err != nil && ctx.Err() != nil:
+		// the request was cancelled by the caller, it says nothing about the API
+	case err != nil || resp.StatusCode >= 500:
+		cb.failures++
+
+		if cb.state == CircuitHalfOpen || cb.failures >= cb.settings.FailureThreshold {
+			cb.state = CircuitOpen
+			cb.openedAt = time.Now()
+		}
+	default:
+		cb.failures = 0
+		cb.state = CircuitClosed
+	}
+
+	to := cb.state
+	cb.mu.Unlock()
+
+	cb.notify(from, to)
+}
+
+func (cb *circuitBreaker) notify(from, to CircuitState) {
+	if from != to && cb.settings.OnStateChange != nil {
+		cb.settings.OnStateChange(from, to)
+	}
+}
@@ -0,0 +1,213 @@
+package serverscom
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type stateChangeRecorder struct {
+	mu      sync.Mutex
+	changes []string
+}
+
+func (r *stateChangeRecorder) record(from, to CircuitState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.changes = append(r.changes, from.String()+"->"+to.String())
+}
+
+func (r *stateChangeRecorder) Changes() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.changes...)
+}
+
+func TestCircuitBreakerTripsAndRecovers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var (
+		mu    sync.Mutex
+		calls int
+		fail  = true
+	)
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		calls++
+
+		if fail {
+			return nil, errors.New("connection refused")
+		}
+
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	states := &stateChangeRecorder{}
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/"+sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		BuildWithOptions(
+			WithTransport(transport),
+			WithCircuitBreaker(CircuitBreakerSettings{
+				FailureThreshold: 2,
+				OpenTimeout:      50 * time.Millisecond,
+				OnStateChange:    states.record,
+			}),
+		)
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+		g.Expect(err).NotTo(BeNil())
+		g.Expect(err).NotTo(BeAssignableToTypeOf(&CircuitOpenError{}))
+	}
+
+	g.Expect(states.Changes()).To(Equal([]string{"closed->open"}))
+
+	_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&CircuitOpenError{}))
+	g.Expect(err.(*CircuitOpenError).RetryAfter).To(BeNumerically(">", 0))
+	g.Expect(calls).To(Equal(2))
+
+	time.Sleep(60 * time.Millisecond)
+
+	mu.Lock()
+	fail = false
+	mu.Unlock()
+
+	sshKey, err := client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeNil())
+	g.Expect(sshKey.Fingerprint).To(Equal(sshFingerprint))
+	g.Expect(calls).To(Equal(3))
+
+	g.Expect(states.Changes()).To(Equal([]string{"closed->open", "open->half-open", "half-open->closed"}))
+}
+
+func TestCircuitBreakerReopensAfterFailedProbe(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "INTERNAL", "message": "Oops"}`).
+		WithResponseCode(500).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "INTERNAL", "message": "Oops"}`).
+		WithResponseCode(500).
+		BuildWithOptions(WithCircuitBreaker(CircuitBreakerSettings{
+			FailureThreshold: 1,
+			OpenTimeout:      20 * time.Millisecond,
+		}))
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&InternalServerError{}))
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&CircuitOpenError{}))
+
+	time.Sleep(30 * time.Millisecond)
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&InternalServerError{}))
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&CircuitOpenError{}))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestCircuitBreakerAllowsSingleProbe(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cb := newCircuitBreaker(&CircuitBreakerSettings{FailureThreshold: 1, OpenTimeout: time.Millisecond})
+
+	ctx := context.TODO()
+
+	probe, err := cb.allow()
+	g.Expect(err).To(Succeed())
+	g.Expect(probe).To(BeZero())
+	cb.record(ctx, probe, &Response{StatusCode: 503}, nil)
+
+	time.Sleep(2 * time.Millisecond)
+
+	probe, err = cb.allow()
+	g.Expect(err).To(Succeed())
+	g.Expect(probe).NotTo(BeZero())
+
+	_, err = cb.allow()
+	g.Expect(err).To(MatchError(&CircuitOpenError{}))
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	cb.record(cancelled, probe, nil, context.Canceled)
+
+	probe, err = cb.allow()
+	g.Expect(err).To(Succeed())
+	cb.record(ctx, probe, &Response{StatusCode: 404}, nil)
+
+	g.Expect(cb.state).To(Equal(CircuitClosed))
+}
+
+func TestCircuitBreakerIgnoresStaleRequestWhileHalfOpen(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	recorder := &stateChangeRecorder{}
+	cb := newCircuitBreaker(&CircuitBreakerSettings{
+		FailureThreshold: 1,
+		OpenTimeout:      time.Millisecond,
+		OnStateChange:    recorder.record,
+	})
+
+	ctx := context.TODO()
+
+	slow, err := cb.allow()
+	g.Expect(err).To(Succeed())
+
+	failing, err := cb.allow()
+	g.Expect(err).To(Succeed())
+	cb.record(ctx, failing, &Response{StatusCode: 503}, nil)
+
+	time.Sleep(2 * time.Millisecond)
+
+	probe, err := cb.allow()
+	g.Expect(err).To(Succeed())
+
+	// the slow request was sent before the breaker tripped, its success says nothing about recovery
+	cb.record(ctx, slow, &Response{StatusCode: 200}, nil)
+
+	g.Expect(cb.state).To(Equal(CircuitHalfOpen))
+
+	_, err = cb.allow()
+	g.Expect(err).To(MatchError(&CircuitOpenError{}))
+
+	cb.record(ctx, probe, &Response{StatusCode: 503}, nil)
+
+	g.Expect(cb.state).To(Equal(CircuitOpen))
+
+	// a result of the finished probe is ignored too
+	cb.record(ctx, probe, &Response{StatusCode: 200}, nil)
+
+	g.Expect(cb.state).To(Equal(CircuitOpen))
+	g.Expect(recorder.Changes()).To(Equal([]string{"closed->open", "open->half-open", "half-open->open"}))
+}
@@ -32,12 +32,13 @@ type CloudBlockStorageBackupsHandler struct {
 
 // Collection builds a new Collection[CloudBlockStorageBackup] interface
 func (h *CloudBlockStorageBackupsHandler) Collection() Collection[CloudBlockStorageBackup] {
-	return NewCollection[CloudBlockStorageBackup](h.client, cloudBlockStorageBackupPath)
+	return NewCollection[CloudBlockStorageBackup](h.client, cloudBlockStorageBackupPath).withOperation("CloudBlockStorageBackups.Collection", cloudBlockStorageBackupPath)
 }
 
 // Get a volume backup
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Backup/operation/GetAVolumeBackup
 func (h *CloudBlockStorageBackupsHandler) Get(ctx context.Context, id string) (*CloudBlockStorageBackup, error) {
+	ctx = withOperation(ctx, "CloudBlockStorageBackups.Get", cloudBlockStorageBackupPathWithID, id)
 	url := h.client.buildURL(cloudBlockStorageBackupPathWithID, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -63,6 +64,8 @@ func (h *CloudBlockStorageBackupsHandler) Create(ctx context.Context, input Clou
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudBlockStorageBackups.Create", cloudBlockStorageBackupPath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudBlockStorageBackupPath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -88,6 +91,8 @@ func (h *CloudBlockStorageBackupsHandler) Update(ctx context.Context, id string,
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudBlockStorageBackups.Update", cloudBlockStorageBackupPathWithID, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudBlockStorageBackupPathWithID, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -107,6 +112,7 @@ func (h *CloudBlockStorageBackupsHandler) Update(ctx context.Context, id string,
 // Delete a volume backup
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Backup/operation/DeleteAVolumeBackup
 func (h *CloudBlockStorageBackupsHandler) Delete(ctx context.Context, id string) (*CloudBlockStorageBackup, error) {
+	ctx = withOperation(ctx, "CloudBlockStorageBackups.Delete", cloudBlockStorageBackupPathWithID, id)
 	url := h.client.buildURL(cloudBlockStorageBackupPathWithID, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -132,6 +138,8 @@ func (h *CloudBlockStorageBackupsHandler) Restore(ctx context.Context, id string
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudBlockStorageBackups.Restore", cloudBlockStorageBackupPathWithID+actionRestore, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudBlockStorageBackupPathWithID+actionRestore, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
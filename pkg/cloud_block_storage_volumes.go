@@ -34,12 +34,13 @@ type CloudBlockStorageVolumesHandler struct {
 
 // Collection builds a new Collection[CloudBlockStorageVolume] interface
 func (h *CloudBlockStorageVolumesHandler) Collection() Collection[CloudBlockStorageVolume] {
-	return NewCollection[CloudBlockStorageVolume](h.client, cloudBlockStorageVolumePath)
+	return NewCollection[CloudBlockStorageVolume](h.client, cloudBlockStorageVolumePath).withOperation("CloudBlockStorageVolumes.Collection", cloudBlockStorageVolumePath)
 }
 
 // Get a cloud volume
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Volume/operation/GetACloudVolume
 func (h *CloudBlockStorageVolumesHandler) Get(ctx context.Context, id string) (*CloudBlockStorageVolume, error) {
+	ctx = withOperation(ctx, "CloudBlockStorageVolumes.Get", cloudBlockStorageVolumePathWithID, id)
 	url := h.client.buildURL(cloudBlockStorageVolumePathWithID, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -65,6 +66,8 @@ func (h *CloudBlockStorageVolumesHandler) Create(ctx context.Context, input Clou
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudBlockStorageVolumes.Create", cloudBlockStorageVolumePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudBlockStorageVolumePath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -90,6 +93,8 @@ func (h *CloudBlockStorageVolumesHandler) Update(ctx context.Context, id string,
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudBlockStorageVolumes.Update", cloudBlockStorageVolumePathWithID, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudBlockStorageVolumePathWithID, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -109,6 +114,7 @@ func (h *CloudBlockStorageVolumesHandler) Update(ctx context.Context, id string,
 // Delete a cloud volume
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Volume/operation/DeleteACloudVolume
 func (h *CloudBlockStorageVolumesHandler) Delete(ctx context.Context, id string) (*CloudBlockStorageVolume, error) {
+	ctx = withOperation(ctx, "CloudBlockStorageVolumes.Delete", cloudBlockStorageVolumePathWithID, id)
 	url := h.client.buildURL(cloudBlockStorageVolumePathWithID, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -134,6 +140,8 @@ func (h *CloudBlockStorageVolumesHandler) Attach(ctx context.Context, id string,
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudBlockStorageVolumes.Attach", cloudBlockStorageVolumePathWithID+actionAttach, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudBlockStorageVolumePathWithID+actionAttach, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -159,6 +167,8 @@ func (h *CloudBlockStorageVolumesHandler) Detach(ctx context.Context, id string,
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudBlockStorageVolumes.Detach", cloudBlockStorageVolumePathWithID+actionDetach, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudBlockStorageVolumePathWithID+actionDetach, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
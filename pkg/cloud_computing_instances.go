@@ -63,12 +63,13 @@ type CloudComputingInstancesHandler struct {
 // Collection builds a new Collection[CloudComputingInstance] interface
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/ListCloudInstances
 func (h *CloudComputingInstancesHandler) Collection() Collection[CloudComputingInstance] {
-	return NewCollection[CloudComputingInstance](h.client, cloudInstanceListPath)
+	return NewCollection[CloudComputingInstance](h.client, cloudInstanceListPath).withOperation("CloudComputingInstances.Collection", cloudInstanceListPath)
 }
 
 // Get cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/GetACloudInstance
 func (h *CloudComputingInstancesHandler) Get(ctx context.Context, id string) (*CloudComputingInstance, error) {
+	ctx = withOperation(ctx, "CloudComputingInstances.Get", cloudInstancePath, id)
 	url := h.client.buildURL(cloudInstancePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -95,6 +96,8 @@ func (h *CloudComputingInstancesHandler) Create(ctx context.Context, input Cloud
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudComputingInstances.Create", cloudInstanceCreatePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudInstanceCreatePath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -121,6 +124,8 @@ func (h *CloudComputingInstancesHandler) Update(ctx context.Context, id string,
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudComputingInstances.Update", cloudInstanceUpdatePath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudInstanceUpdatePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -141,6 +146,7 @@ func (h *CloudComputingInstancesHandler) Update(ctx context.Context, id string,
 // Delete cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/DeleteACloudInstance
 func (h *CloudComputingInstancesHandler) Delete(ctx context.Context, id string) error {
+	ctx = withOperation(ctx, "CloudComputingInstances.Delete", cloudInstanceDeletePath, id)
 	url := h.client.buildURL(cloudInstanceDeletePath, id)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -157,6 +163,8 @@ func (h *CloudComputingInstancesHandler) Reinstall(ctx context.Context, id strin
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudComputingInstances.Reinstall", cloudInstanceReinstallPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudInstanceReinstallPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -177,6 +185,7 @@ func (h *CloudComputingInstancesHandler) Reinstall(ctx context.Context, id strin
 // Rescue cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/ActivateRescueModeForACloudInstance
 func (h *CloudComputingInstancesHandler) Rescue(ctx context.Context, id string) (*CloudComputingInstance, error) {
+	ctx = withOperation(ctx, "CloudComputingInstances.Rescue", cloudInstanceRescuePath, id)
 	url := h.client.buildURL(cloudInstanceRescuePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -197,6 +206,7 @@ func (h *CloudComputingInstancesHandler) Rescue(ctx context.Context, id string)
 // Unrescue cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/DeactivateRescueModeForACloudInstance
 func (h *CloudComputingInstancesHandler) Unrescue(ctx context.Context, id string) (*CloudComputingInstance, error) {
+	ctx = withOperation(ctx, "CloudComputingInstances.Unrescue", cloudInstanceUnrescuePath, id)
 	url := h.client.buildURL(cloudInstanceUnrescuePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -223,6 +233,8 @@ func (h *CloudComputingInstancesHandler) Upgrade(ctx context.Context, id string,
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "CloudComputingInstances.Upgrade", cloudInstanceUpgradePath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudInstanceUpgradePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -243,6 +255,7 @@ func (h *CloudComputingInstancesHandler) Upgrade(ctx context.Context, id string,
 // RevertUpgrade cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/RevertUpgradeForACloudInstance
 func (h *CloudComputingInstancesHandler) RevertUpgrade(ctx context.Context, id string) (*CloudComputingInstance, error) {
+	ctx = withOperation(ctx, "CloudComputingInstances.RevertUpgrade", cloudInstanceRevertUpgradePath, id)
 	url := h.client.buildURL(cloudInstanceRevertUpgradePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -263,6 +276,7 @@ func (h *CloudComputingInstancesHandler) RevertUpgrade(ctx context.Context, id s
 // ApproveUpgrade cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/ApproveUpgradeForACloudInstance
 func (h *CloudComputingInstancesHandler) ApproveUpgrade(ctx context.Context, id string) (*CloudComputingInstance, error) {
+	ctx = withOperation(ctx, "CloudComputingInstances.ApproveUpgrade", cloudInstanceApproveUpgradePath, id)
 	url := h.client.buildURL(cloudInstanceApproveUpgradePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -283,6 +297,7 @@ func (h *CloudComputingInstancesHandler) ApproveUpgrade(ctx context.Context, id
 // PowerOn cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/PowerOnACloudInstance
 func (h *CloudComputingInstancesHandler) PowerOn(ctx context.Context, id string) (*CloudComputingInstance, error) {
+	ctx = withOperation(ctx, "CloudComputingInstances.PowerOn", cloudInstancePowerOnPath, id)
 	url := h.client.buildURL(cloudInstancePowerOnPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -303,6 +318,7 @@ func (h *CloudComputingInstancesHandler) PowerOn(ctx context.Context, id string)
 // PowerOff cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/PowerOffACloudInstance
 func (h *CloudComputingInstancesHandler) PowerOff(ctx context.Context, id string) (*CloudComputingInstance, error) {
+	ctx = withOperation(ctx, "CloudComputingInstances.PowerOff", cloudInstancePowerOffPath, id)
 	url := h.client.buildURL(cloudInstancePowerOffPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -323,6 +339,7 @@ func (h *CloudComputingInstancesHandler) PowerOff(ctx context.Context, id string
 // Reboot cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/RebootACloudInstance
 func (h *CloudComputingInstancesHandler) Reboot(ctx context.Context, id string) (*CloudComputingInstance, error) {
+	ctx = withOperation(ctx, "CloudComputingInstances.Reboot", cloudInstanceRebootPath, id)
 	url := h.client.buildURL(cloudInstanceRebootPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -345,12 +362,13 @@ func (h *CloudComputingInstancesHandler) Reboot(ctx context.Context, id string)
 func (h *CloudComputingInstancesHandler) PTRRecords(id string) Collection[PTRRecord] {
 	path := h.client.buildPath(cloudInstancePTRsListPath, []interface{}{id}...)
 
-	return NewCollection[PTRRecord](h.client, path)
+	return NewCollection[PTRRecord](h.client, path).withOperation("CloudComputingInstances.PTRRecords", cloudInstancePTRsListPath, id)
 }
 
 // CreatePTRRecord creates ptr record for the cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/CreateAPtrRecordForACloudInstance
 func (h *CloudComputingInstancesHandler) CreatePTRRecord(ctx context.Context, cloudInstanceID string, input PTRRecordCreateInput) (*PTRRecord, error) {
+	ctx = withOperation(ctx, "CloudComputingInstances.CreatePTRRecord", cloudInstanceCreatePTRRecordPath, cloudInstanceID)
 	url := h.client.buildURL(cloudInstanceCreatePTRRecordPath, cloudInstanceID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -371,6 +389,7 @@ func (h *CloudComputingInstancesHandler) CreatePTRRecord(ctx context.Context, cl
 // DeletePTRRecord deleted ptr record for the cloud instance
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Cloud-Instance/operation/DeleteAPtrRecordForACloudInstance
 func (h *CloudComputingInstancesHandler) DeletePTRRecord(ctx context.Context, cloudInstanceID string, ptrRecordID string) error {
+	ctx = withOperation(ctx, "CloudComputingInstances.DeletePTRRecord", cloudInstanceDeletePTRRecordPath, cloudInstanceID, ptrRecordID)
 	url := h.client.buildURL(cloudInstanceDeletePTRRecordPath, cloudInstanceID, ptrRecordID)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -38,32 +38,33 @@ type CloudComputingRegionsHandler struct {
 
 // Collection builds a new Collection[CloudComputingRegion] interface
 func (h *CloudComputingRegionsHandler) Collection() Collection[CloudComputingRegion] {
-	return NewCollection[CloudComputingRegion](h.client, cloudComputingRegionListPath)
+	return NewCollection[CloudComputingRegion](h.client, cloudComputingRegionListPath).withOperation("CloudComputingRegions.Collection", cloudComputingRegionListPath)
 }
 
 // Images builds a new Collection[CloudComputingImage] interface
 func (h *CloudComputingRegionsHandler) Images(regionID int64) Collection[CloudComputingImage] {
 	path := h.client.buildPath(cloudComputingImageListPath, regionID)
 
-	return NewCollection[CloudComputingImage](h.client, path)
+	return NewCollection[CloudComputingImage](h.client, path).withOperation("CloudComputingRegions.Images", cloudComputingImageListPath, regionID)
 }
 
 // Flavors builds a new Collection[CloudComputingFlavor] interface
 func (h *CloudComputingRegionsHandler) Flavors(regionID int64) Collection[CloudComputingFlavor] {
 	path := h.client.buildPath(cloudComputingFlavorListPath, regionID)
 
-	return NewCollection[CloudComputingFlavor](h.client, path)
+	return NewCollection[CloudComputingFlavor](h.client, path).withOperation("CloudComputingRegions.Flavors", cloudComputingFlavorListPath, regionID)
 }
 
 // Snapshots builds a new Collection[CloudSnapshot] interface
 func (h *CloudComputingRegionsHandler) Snapshots(regionID int64) Collection[CloudSnapshot] {
 	path := h.client.buildPath(cloudComputingSnapshotListPath, regionID)
 
-	return NewCollection[CloudSnapshot](h.client, path)
+	return NewCollection[CloudSnapshot](h.client, path).withOperation("CloudComputingRegions.Snapshots", cloudComputingSnapshotListPath, regionID)
 }
 
 // Credentials returns cloud region OpenStack credentials
 func (h *CloudComputingRegionsHandler) Credentials(ctx context.Context, regionID int64) (*CloudComputingRegionCredentials, error) {
+	ctx = withOperation(ctx, "CloudComputingRegions.Credentials", cloudComputingCredentialsPath, regionID)
 	url := h.client.buildURL(cloudComputingCredentialsPath, regionID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -83,6 +84,8 @@ func (h *CloudComputingRegionsHandler) Credentials(ctx context.Context, regionID
 
 // CreateSnapshot creates a snapshot for a cloud instance
 func (h *CloudComputingRegionsHandler) CreateSnapshot(ctx context.Context, regionID int64, input CloudSnapshotCreateInput) (*CloudSnapshot, error) {
+	ctx = withOperation(ctx, "CloudComputingRegions.CreateSnapshot", cloudComputingSnapshotListPath, regionID)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(cloudComputingSnapshotListPath, regionID)
 
 	payload, err := json.Marshal(input)
@@ -108,6 +111,7 @@ func (h *CloudComputingRegionsHandler) CreateSnapshot(ctx context.Context, regio
 
 // DeleteSnapshot deletes a snapshot
 func (h *CloudComputingRegionsHandler) DeleteSnapshot(ctx context.Context, regionID int64, snapshotID string) error {
+	ctx = withOperation(ctx, "CloudComputingRegions.DeleteSnapshot", cloudComputingSnapshotDeletePath, regionID, snapshotID)
 	url := h.client.buildURL(cloudComputingSnapshotDeletePath, regionID, snapshotID)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+const defaultCollectConcurrency = 4
+
 // Collection is an interface for interfacing with the collection
 type Collection[K any] interface {
 	IsClean() bool
@@ -23,22 +27,68 @@ type Collection[K any] interface {
 	LastPage(ctx context.Context) ([]K, error)
 
 	Collect(ctx context.Context) ([]K, error)
+	CollectParallel(ctx context.Context, concurrency int) ([]K, error)
 	List(ctx context.Context) ([]K, error)
 
+	All(ctx context.Context) iter.Seq2[K, error]
+	Pages(ctx context.Context) iter.Seq2[[]K, error]
+
 	SetPage(page int) Collection[K]
 	SetPerPage(perPage int) Collection[K]
 	SetParam(name, value string) Collection[K]
+	WithFilter(filter Filter[K]) Collection[K]
 
 	Refresh(ctx context.Context) error
+
+	Clone() Collection[K]
+	Fetch(ctx context.Context, page int) (*Page[K], error)
+
+	Cursor() string
+	NextCursor() (string, bool)
+	FromCursor(cursor string) (Collection[K], error)
+	CurrentPage() int
+	TotalPages() int
+}
+
+// Page is a page of a collection returned by Fetch
+type Page[K any] struct {
+	// Number is a number of the page, starting from 1
+	Number int
+	Items  []K
+
+	// Rels are pagination links of the page by their names: next, prev, first, last
+	Rels map[string]string
+}
+
+// HasNextPage returns true if the page isn't the last one
+func (p *Page[K]) HasNextPage() bool {
+	_, ok := p.Rels["next"]
+
+	return ok
+}
+
+// HasPreviousPage returns true if the page isn't the first one
+func (p *Page[K]) HasPreviousPage() bool {
+	_, ok := p.Rels["prev"]
+
+	return ok
 }
 
-// CollectionHandler handles operations around collection
+// CollectionHandler handles operations around collection, it's safe for concurrent use.
+//
+// Requests aren't serialized: when goroutines navigate the same collection concurrently,
+// the state is left by the last completed request. Use Clone or Fetch to page independently.
 type CollectionHandler[K any] struct {
 	client *Client
 
-	path string
+	path      string
+	operation operation
+
+	// mu guards params, filterErr, clean, rels and collection
+	mu sync.RWMutex
 
-	params map[string]string
+	params    map[string]string
+	filterErr error
 
 	clean bool
 
@@ -51,7 +101,8 @@ func NewCollection[K any](client *Client, path string) *CollectionHandler[K] {
 	return &CollectionHandler[K]{
 		client: client,
 
-		path: path,
+		path:      path,
+		operation: operation{Path: path},
 
 		params:     make(map[string]string),
 		rels:       make(map[string]string),
@@ -62,6 +113,9 @@ func NewCollection[K any](client *Client, path string) *CollectionHandler[K] {
 
 // IsClean returns a bool value where true is means, this collection not used yet and doesn't contain any state.
 func (col *CollectionHandler[K]) IsClean() bool {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
 	return col.clean
 }
 
@@ -144,7 +198,10 @@ func (col *CollectionHandler[K]) LastPage(ctx context.Context) ([]K, error) {
 // Collect navigates by pages until the last page is reached will be reached and returns accumulated data between pages.
 //
 // This method uses NextPage.
-func (col *CollectionHandler[K]) Collect(ctx context.Context) ([]K, error) {
+func (col *CollectionHandler[K]) Collect(ctx context.Context) (_ []K, err error) {
+	ctx, span := col.client.tracing.startCollectSpan(ctx, col.operation, "Collect")
+	defer func() { endSpan(span, nil, err) }()
+
 	var accumulatedCollectionElements []K
 
 	currentCollectionElements, err := col.List(ctx)
@@ -168,6 +225,246 @@ func (col *CollectionHandler[K]) Collect(ctx context.Context) ([]K, error) {
 	return accumulatedCollectionElements, nil
 }
 
+// CollectParallel works like Collect, but fetches pages after the first one concurrently
+// using up to concurrency requests at once, by default: 4.
+//
+// A number of pages is taken from the last rel of the first page, if there is no last rel
+// the collection is walked by NextPage. Elements are returned in page order, the first error
+// cancels requests in flight and is returned.
+func (col *CollectionHandler[K]) CollectParallel(ctx context.Context, concurrency int) (_ []K, err error) {
+	ctx, span := col.client.tracing.startCollectSpan(ctx, col.operation, "CollectParallel")
+	defer func() { endSpan(span, nil, err) }()
+
+	if concurrency <= 0 {
+		concurrency = defaultCollectConcurrency
+	}
+
+	firstPage, err := col.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !col.HasNextPage() {
+		return firstPage, nil
+	}
+
+	currentPage, lastPage, perPage, ok := col.pageRange()
+	if !ok {
+		return col.collectSequentially(ctx, firstPage)
+	}
+
+	col.applyParam("per_page", perPage)
+
+	params, err := col.query()
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([][]K, lastPage-currentPage+1)
+	pages[0] = firstPage
+
+	lastRels, err := col.fetchPages(ctx, params, pages, currentPage, concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	var accumulatedCollectionElements []K
+
+	for _, page := range pages {
+		accumulatedCollectionElements = append(accumulatedCollectionElements, page...)
+	}
+
+	col.mu.Lock()
+	defer col.mu.Unlock()
+
+	col.setParam("page", strconv.Itoa(lastPage))
+	col.collection = pages[len(pages)-1]
+	col.rels = lastRels
+
+	return accumulatedCollectionElements, nil
+}
+
+// pageRange returns numbers of the current and the last page and per_page of the last rel
+func (col *CollectionHandler[K]) pageRange() (int, int, string, bool) {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
+	lastURL, err := url.Parse(col.rels["last"])
+	if err != nil {
+		return 0, 0, "", false
+	}
+
+	lastPage, err := strconv.Atoi(lastURL.Query().Get("page"))
+	if err != nil {
+		return 0, 0, "", false
+	}
+
+	currentPage := 1
+
+	if page, ok := col.params["page"]; ok {
+		if currentPage, err = strconv.Atoi(page); err != nil {
+			return 0, 0, "", false
+		}
+	}
+
+	if lastPage <= currentPage {
+		return 0, 0, "", false
+	}
+
+	return currentPage, lastPage, lastURL.Query().Get("per_page"), true
+}
+
+// fetchPages fills pages after the first one, which number is firstPage, and returns rels of the last page
+func (col *CollectionHandler[K]) fetchPages(ctx context.Context, query map[string]string, pages [][]K, firstPage, concurrency int) (map[string]string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		lastRels map[string]string
+	)
+
+	semaphore := make(chan struct{}, concurrency)
+
+	for i := 1; i < len(pages); i++ {
+		params := copyParams(query)
+		params["page"] = strconv.Itoa(firstPage + i)
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func(i int, params map[string]string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			elements, rels, err := col.fetchPage(ctx, params)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+
+				return
+			}
+
+			pages[i] = elements
+
+			if i == len(pages)-1 {
+				lastRels = rels
+			}
+		}(i, params)
+	}
+
+	wg.Wait()
+
+	if firstErr == nil && ctx.Err() != nil {
+		firstErr = ctx.Err()
+	}
+
+	return lastRels, firstErr
+}
+
+func (col *CollectionHandler[K]) collectSequentially(ctx context.Context, firstPage []K) ([]K, error) {
+	accumulatedCollectionElements := append([]K(nil), firstPage...)
+
+	for col.HasNextPage() {
+		nextCollectionElements, err := col.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		accumulatedCollectionElements = append(accumulatedCollectionElements, nextCollectionElements...)
+	}
+
+	return accumulatedCollectionElements, nil
+}
+
+// Pages returns an iterator over pages, which are fetched lazily when the previous page is consumed.
+//
+// Every iteration starts from the configured page, by default: the first one, and follows the next rel,
+// it stops when the consumer breaks the loop or after yielding an error. Iteration doesn't change
+// the state of the collection, so the iterator can be ranged again.
+//
+//	for hosts, err := range collection.Pages(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (col *CollectionHandler[K]) Pages(ctx context.Context) iter.Seq2[[]K, error] {
+	return col.pages(ctx, "Pages")
+}
+
+// pages returns an iterator over pages traced by a span of the method
+func (col *CollectionHandler[K]) pages(ctx context.Context, method string) iter.Seq2[[]K, error] {
+	return func(yield func([]K, error) bool) {
+		var err error
+
+		ctx, span := col.client.tracing.startCollectSpan(ctx, col.operation, method)
+		defer func() { endSpan(span, nil, err) }()
+
+		pages := col.cleanClone()
+
+		page, err := pages.List(ctx)
+
+		for {
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			if !yield(page, nil) || !pages.HasNextPage() {
+				return
+			}
+
+			page, err = pages.NextPage(ctx)
+		}
+	}
+}
+
+// All returns an iterator over elements of all pages, see Pages.
+//
+//	for host, err := range collection.All(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (col *CollectionHandler[K]) All(ctx context.Context) iter.Seq2[K, error] {
+	return func(yield func(K, error) bool) {
+		for page, err := range col.pages(ctx, "All") {
+			if err != nil {
+				var zero K
+
+				yield(zero, err)
+
+				return
+			}
+
+			for _, element := range page {
+				if !yield(element, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // List returns a []BandwidthOption limited by pagination.
 //
 // This method performs request only once when IsClean returns false, also this request doesn't
@@ -176,11 +473,12 @@ func (col *CollectionHandler[K]) Collect(ctx context.Context) ([]K, error) {
 // In the case when previously called method is Collect, this method returns data from the last page.
 func (col *CollectionHandler[K]) List(ctx context.Context) ([]K, error) {
 	if col.IsClean() {
-		if err := col.Refresh(ctx); err != nil {
-			return nil, err
-		}
+		return col.fireHTTPRequest(ctx)
 	}
 
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
 	return col.collection, nil
 }
 
@@ -221,41 +519,169 @@ func (col *CollectionHandler[K]) SetParam(name, value string) Collection[K] {
 	return col
 }
 
+// WithFilter sets params of the filter, params which aren't set by the filter are removed.
+//
+// An error returned by the filter is returned by the next request of the collection.
+func (col *CollectionHandler[K]) WithFilter(filter Filter[K]) Collection[K] {
+	params, err := filter.Params()
+
+	col.mu.Lock()
+	defer col.mu.Unlock()
+
+	if err != nil {
+		col.filterErr = fmt.Errorf("Collection filter error: %w", err)
+
+		return col
+	}
+
+	col.filterErr = nil
+
+	for name, value := range params {
+		col.setParam(name, value)
+	}
+
+	return col
+}
+
 // Refresh performs the request and then updates accumulated data limited by pagination.
 //
 // After calling this method accumulated data can be extracted by List method.
 func (col *CollectionHandler[K]) Refresh(ctx context.Context) error {
-	if err := col.fireHTTPRequest(ctx); err != nil {
+	if _, err := col.fireHTTPRequest(ctx); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (col *CollectionHandler[K]) fireHTTPRequest(ctx context.Context) error {
+// Clone returns a copy of the collection with its params, filter and pagination state,
+// the copy and the original can be used independently.
+//
+// A configured collection can be used as a template:
+//
+//	base := client.Hosts.ListDedicatedServers().SetPerPage(100)
+//	prod := base.Clone().WithFilter(DedicatedServerListFilter{LabelSelector: labels.MustParse("env=prod")})
+func (col *CollectionHandler[K]) Clone() Collection[K] {
+	return col.clone()
+}
+
+func (col *CollectionHandler[K]) clone() *CollectionHandler[K] {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
+	return &CollectionHandler[K]{
+		client: col.client,
+
+		path:      col.path,
+		operation: col.operation,
+
+		params:     copyParams(col.params),
+		filterErr:  col.filterErr,
+		rels:       copyParams(col.rels),
+		clean:      col.clean,
+		collection: append(make([]K, 0, len(col.collection)), col.collection...),
+	}
+}
+
+// cleanClone returns a copy of the collection without pagination state, which starts from the configured page
+func (col *CollectionHandler[K]) cleanClone() *CollectionHandler[K] {
+	clone := col.clone()
+
+	clone.clean = true
+	clone.rels = make(map[string]string)
+	clone.collection = make([]K, 0)
+
+	return clone
+}
+
+// Fetch requests the page with the current params of the collection, pages start from 1.
+//
+// Unlike navigation methods Fetch doesn't change the collection, so pages can be fetched
+// concurrently.
+func (col *CollectionHandler[K]) Fetch(ctx context.Context, page int) (*Page[K], error) {
+	params, err := col.query()
+	if err != nil {
+		return nil, err
+	}
+
+	if page > 1 {
+		params["page"] = strconv.Itoa(page)
+	} else {
+		page = 1
+		delete(params, "page")
+	}
+
+	elements, rels, err := col.fetchPage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Page[K]{Number: page, Items: elements, Rels: rels}, nil
+}
+
+func (col *CollectionHandler[K]) fireHTTPRequest(ctx context.Context) ([]K, error) {
+	params, err := col.query()
+	if err != nil {
+		return nil, err
+	}
+
+	elements, rels, err := col.fetchPage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	col.mu.Lock()
+	defer col.mu.Unlock()
+
+	col.clean = false
+	col.collection = elements
+	col.rels = rels
+
+	return elements, nil
+}
+
+// query returns a copy of params or an error of the filter
+func (col *CollectionHandler[K]) query() (map[string]string, error) {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
+	if col.filterErr != nil {
+		return nil, col.filterErr
+	}
+
+	return copyParams(col.params), nil
+}
+
+// fetchPage requests a page with params, the collection state isn't changed
+func (col *CollectionHandler[K]) fetchPage(ctx context.Context, params map[string]string) ([]K, map[string]string, error) {
 	var accumulatedCollectionElements []K
 
 	//nolint:govet
 	initialURL := col.client.buildURL(col.path)
 	url := col.client.applyParams(
 		initialURL,
-		col.params,
+		params,
 	)
 
+	ctx = contextWithOperation(ctx, col.operation)
+
 	response, body, err := col.client.buildAndExecRequestWithResponse(ctx, "GET", url, nil)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if err := json.Unmarshal(body, &accumulatedCollectionElements); err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	col.clean = false
-	col.collection = accumulatedCollectionElements
-	col.rels = hyperHeaderParser(response.Header())
+	return accumulatedCollectionElements, hyperHeaderParser(response.Header), nil
+}
 
-	return nil
+// withOperation describes which service method built the collection, it's used by tracing
+func (col *CollectionHandler[K]) withOperation(name, path string, values ...interface{}) *CollectionHandler[K] {
+	col.operation = newOperation(name, path, values...)
+
+	return col
 }
 
 func (col *CollectionHandler[K]) navigate(ctx context.Context, name string) ([]K, error) {
@@ -269,14 +695,18 @@ func (col *CollectionHandler[K]) navigate(ctx context.Context, name string) ([]K
 		return nil, err
 	}
 
-	if err := col.Refresh(ctx); err != nil {
-		return nil, err
-	}
-
-	return col.collection, nil
+	return col.fireHTTPRequest(ctx)
 }
 
 func (col *CollectionHandler[K]) applyParam(name, value string) {
+	col.mu.Lock()
+	defer col.mu.Unlock()
+
+	col.setParam(name, value)
+}
+
+// setParam sets or removes the param, it requires mu to be locked
+func (col *CollectionHandler[K]) setParam(name, value string) {
 	if value == "" {
 		delete(col.params, name)
 	} else {
@@ -285,26 +715,43 @@ func (col *CollectionHandler[K]) applyParam(name, value string) {
 }
 
 func (col *CollectionHandler[K]) applyRel(name string) error {
-	if !col.hasRel(name) {
+	col.mu.Lock()
+	defer col.mu.Unlock()
+
+	rel, ok := col.rels[name]
+	if !ok {
 		return fmt.Errorf("No rel for: %s", name)
 	}
 
-	url, err := url.Parse(col.rels[name])
+	url, err := url.Parse(rel)
 
 	if err != nil {
 		return err
 	}
 
-	col.applyParam("page", url.Query().Get("page"))
-	col.applyParam("per_page", url.Query().Get("per_page"))
+	col.setParam("page", url.Query().Get("page"))
+	col.setParam("per_page", url.Query().Get("per_page"))
 
 	return nil
 }
 
 func (col *CollectionHandler[K]) hasRel(name string) bool {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
 	if _, ok := col.rels[name]; ok {
 		return true
 	}
 
 	return false
 }
+
+func copyParams(params map[string]string) map[string]string {
+	copied := make(map[string]string, len(params))
+
+	for name, value := range params {
+		copied[name] = value
+	}
+
+	return copied
+}
@@ -0,0 +1,187 @@
+package serverscom
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// collectionCursor is a state of a collection encoded by Cursor
+type collectionCursor struct {
+	Path   string            `json:"path"`
+	Params map[string]string `json:"params,omitempty"`
+	Page   int               `json:"page"`
+}
+
+// Cursor returns an opaque token with the path, the params and the current page of the collection,
+// the token can be persisted and passed to FromCursor to return to the current page later.
+//
+// Resuming from this cursor repeats the current page, use NextCursor to resume after a processed page.
+func (col *CollectionHandler[K]) Cursor() string {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
+	return col.encodeCursor(col.params, col.currentPage())
+}
+
+// NextCursor returns an opaque token like Cursor, but for the page after the current one, it returns
+// false when the collection has no next page. Save it after processing a page to resume an export
+// without repeating pages:
+//
+//	invoices, err := collection.List(ctx)
+//	for err == nil {
+//		process(invoices)
+//
+//		cursor, ok := collection.NextCursor()
+//		if !ok {
+//			break
+//		}
+//
+//		saveCursor(cursor)
+//		invoices, err = collection.NextPage(ctx)
+//	}
+func (col *CollectionHandler[K]) NextCursor() (string, bool) {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
+	page, ok := relPage(col.rels, "next")
+	if !ok {
+		return "", false
+	}
+
+	params := copyParams(col.params)
+
+	if nextURL, err := url.Parse(col.rels["next"]); err == nil && nextURL.Query().Get("per_page") != "" {
+		params["per_page"] = nextURL.Query().Get("per_page")
+	}
+
+	return col.encodeCursor(params, page), true
+}
+
+// encodeCursor encodes the path with params and the page, the page param is replaced by the page
+func (col *CollectionHandler[K]) encodeCursor(params map[string]string, page int) string {
+	cursor := collectionCursor{
+		Path:   col.path,
+		Params: copyParams(params),
+		Page:   page,
+	}
+
+	delete(cursor.Params, "page")
+
+	// marshaling of strings and ints can't fail
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// FromCursor restores params and the page of the collection from a token returned by Cursor or
+// NextCursor, it returns an error if the token is malformed or was produced by a collection with
+// another path.
+//
+// The collection becomes clean, so the next List requests the page of the cursor: for a token of
+// Cursor it's the page the cursor was taken on, so that page is returned again.
+func (col *CollectionHandler[K]) FromCursor(cursor string) (Collection[K], error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid collection cursor: %w", err)
+	}
+
+	var decoded collectionCursor
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("Invalid collection cursor: %w", err)
+	}
+
+	if decoded.Path != col.path {
+		return nil, fmt.Errorf("Collection cursor is for %s, not %s", decoded.Path, col.path)
+	}
+
+	col.mu.Lock()
+	defer col.mu.Unlock()
+
+	col.params = copyParams(decoded.Params)
+	col.filterErr = nil
+
+	if decoded.Page > 1 {
+		col.params["page"] = strconv.Itoa(decoded.Page)
+	}
+
+	col.clean = true
+	col.rels = make(map[string]string)
+	col.collection = make([]K, 0)
+
+	return col, nil
+}
+
+// CurrentPage returns a number of the current page, starting from 1
+func (col *CollectionHandler[K]) CurrentPage() int {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
+	return col.currentPage()
+}
+
+// TotalPages returns a number of pages taken from the last rel, when the collection
+// has no last rel and no next rel the current page is the last one.
+//
+// In case when IsClean returns true or the number is unknown, this method returns 0.
+func (col *CollectionHandler[K]) TotalPages() int {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
+	if col.clean {
+		return 0
+	}
+
+	if page, ok := relPage(col.rels, "last"); ok {
+		return page
+	}
+
+	if _, ok := col.rels["next"]; !ok {
+		return col.currentPage()
+	}
+
+	return 0
+}
+
+// currentPage returns a number of the current page by the page param or by the prev rel,
+// it requires mu to be locked
+func (col *CollectionHandler[K]) currentPage() int {
+	if page, err := strconv.Atoi(col.params["page"]); err == nil && page > 1 {
+		return page
+	}
+
+	if page, ok := relPage(col.rels, "prev"); ok {
+		return page + 1
+	}
+
+	return 1
+}
+
+// relPage returns the page param of the rel
+func relPage(rels map[string]string, name string) (int, bool) {
+	rel, ok := rels[name]
+	if !ok {
+		return 0, false
+	}
+
+	relURL, err := url.Parse(rel)
+	if err != nil {
+		return 0, false
+	}
+
+	page := relURL.Query().Get("page")
+	if page == "" {
+		// the first page may be linked without the page param
+		return 1, true
+	}
+
+	number, err := strconv.Atoi(page)
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
+}
@@ -0,0 +1,137 @@
+package serverscom
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCollectionCursor(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, _ := newPagedHostsServer(4, 0)
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL))
+	collection := NewCollection[Host](client, "/hosts").SetParam("search_pattern", "web")
+
+	g.Expect(collection.CurrentPage()).To(Equal(1))
+	g.Expect(collection.TotalPages()).To(Equal(0))
+
+	_, err := collection.List(ctx)
+	g.Expect(err).To(BeNil())
+
+	_, err = collection.NextPage(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(collection.CurrentPage()).To(Equal(2))
+	g.Expect(collection.TotalPages()).To(Equal(4))
+
+	cursor := collection.Cursor()
+
+	resumed, err := NewCollection[Host](client, "/hosts").FromCursor(cursor)
+	g.Expect(err).To(BeNil())
+	g.Expect(resumed.IsClean()).To(BeTrue())
+	g.Expect(resumed.CurrentPage()).To(Equal(2))
+	g.Expect(resumed.Cursor()).To(Equal(cursor))
+
+	list, err := resumed.Collect(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(list).To(HaveLen(6))
+	g.Expect(list[0].ID).To(Equal("2-a"))
+	g.Expect(resumed.CurrentPage()).To(Equal(4))
+	g.Expect(resumed.TotalPages()).To(Equal(4))
+
+	_, err = NewCollection[Host](client, "/racks").FromCursor(cursor)
+	g.Expect(err).To(MatchError("Collection cursor is for /hosts, not /racks"))
+
+	_, err = NewCollection[Host](client, "/hosts").FromCursor("not a cursor")
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestCollectionNextCursor(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, _ := newPagedHostsServer(4, 0)
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL))
+
+	var (
+		ids    []string
+		cursor string
+		runs   int
+	)
+
+	// every run processes one page and resumes from the cursor saved by the previous run
+	for {
+		runs++
+
+		collection := NewCollection[Host](client, "/hosts")
+
+		if cursor != "" {
+			_, err := collection.FromCursor(cursor)
+			g.Expect(err).To(BeNil())
+		}
+
+		hosts, err := collection.List(ctx)
+		g.Expect(err).To(BeNil())
+
+		for _, host := range hosts {
+			ids = append(ids, host.ID)
+		}
+
+		next, ok := collection.NextCursor()
+		if !ok {
+			break
+		}
+
+		cursor = next
+	}
+
+	g.Expect(runs).To(Equal(4))
+	g.Expect(ids).To(Equal([]string{"1-a", "1-b", "2-a", "2-b", "3-a", "3-b", "4-a", "4-b"}))
+}
+
+func TestCollectionTotalPagesWithoutLastRel(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=2&per_page=2>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "a"}, {"id": "b"}]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithRequestParams(`page=2&per_page=2`).
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?per_page=2>; rel="prev"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "c"}]`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	collection := NewCollection[Host](client, "/hosts")
+
+	_, err := collection.List(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(collection.TotalPages()).To(Equal(0))
+
+	_, err = collection.NextPage(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(collection.CurrentPage()).To(Equal(2))
+	g.Expect(collection.TotalPages()).To(Equal(2))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
@@ -2,7 +2,13 @@ package serverscom
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -410,3 +416,416 @@ func TestHostNetworksCollectionCollect(t *testing.T) {
 	g.Expect(len(list)).To(Equal(6))
 	g.Expect(collection.HasNextPage()).To(Equal(false))
 }
+
+func TestCollectionAll(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=2&per_page=2>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "a"}, {"id": "b"}]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithRequestParams(`page=2&per_page=2`).
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=3&per_page=2>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "c"}, {"id": "d"}]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithRequestParams(`page=3&per_page=2`).
+		WithResponseBodyStubInline(`[{"id": "e"}]`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	collection := NewCollection[Host](client, "/hosts")
+
+	var ids []string
+
+	for host, err := range collection.All(context.TODO()) {
+		g.Expect(err).To(BeNil())
+
+		ids = append(ids, host.ID)
+	}
+
+	g.Expect(ids).To(Equal([]string{"a", "b", "c", "d", "e"}))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestCollectionAllRangedTwice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, _ := newPagedHostsServer(2, 0)
+	defer ts.Close()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL))
+	collection := NewCollection[Host](client, "/hosts")
+
+	all := collection.All(context.TODO())
+
+	for i := 0; i < 2; i++ {
+		var ids []string
+
+		for host, err := range all {
+			g.Expect(err).To(BeNil())
+
+			ids = append(ids, host.ID)
+		}
+
+		g.Expect(ids).To(Equal([]string{"1-a", "1-b", "2-a", "2-b"}))
+	}
+
+	g.Expect(collection.IsClean()).To(BeTrue())
+
+	var ids []string
+
+	for host, err := range collection.SetPerPage(2).SetPage(2).All(context.TODO()) {
+		g.Expect(err).To(BeNil())
+
+		ids = append(ids, host.ID)
+	}
+
+	g.Expect(ids).To(Equal([]string{"2-a", "2-b"}))
+}
+
+func TestCollectionAllStopsEarly(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=2&per_page=2>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "a"}, {"id": "b"}]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithRequestParams(`page=2&per_page=2`).
+		WithResponseBodyStubInline(`[{"id": "c"}, {"id": "d"}]`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	collection := NewCollection[Host](client, "/hosts")
+
+	var ids []string
+
+	for host, err := range collection.All(context.TODO()) {
+		g.Expect(err).To(BeNil())
+
+		ids = append(ids, host.ID)
+
+		if host.ID == "b" {
+			break
+		}
+	}
+
+	g.Expect(ids).To(Equal([]string{"a", "b"}))
+	g.Expect(ts.Requests).To(HaveLen(1))
+}
+
+func TestCollectionPagesError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=2&per_page=2>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "a"}, {"id": "b"}]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithRequestParams(`page=2&per_page=2`).
+		WithResponseBodyStubInline(`{"code": "INTERNAL", "message": "Oops"}`).
+		WithResponseCode(500).
+		Build()
+
+	defer ts.Close()
+
+	collection := NewCollection[Host](client, "/hosts")
+
+	var (
+		pages int
+		errs  []error
+	)
+
+	for page, err := range collection.Pages(context.TODO()) {
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		pages++
+		g.Expect(page).To(HaveLen(2))
+	}
+
+	g.Expect(pages).To(Equal(1))
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0]).To(BeAssignableToTypeOf(&InternalServerError{}))
+}
+
+// newPagedHostsServer serves /hosts split into pages of two hosts with next and last rels
+func newPagedHostsServer(pages int, failPage int) (*httptest.Server, func() int) {
+	var (
+		mu          sync.Mutex
+		inFlight    int
+		maxInFlight int
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+
+		page := 1
+		if value := r.URL.Query().Get("page"); value != "" {
+			page, _ = strconv.Atoi(value)
+
+			if r.URL.Query().Get("per_page") != "2" {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"code": "INTERNAL", "message": "Oops"}`))
+
+			return
+		}
+
+		links := fmt.Sprintf(`<http://%s/hosts?page=%d&per_page=2>; rel="last"`, r.Host, pages)
+		if page < pages {
+			links += fmt.Sprintf(`, <http://%s/hosts?page=%d&per_page=2>; rel="next"`, r.Host, page+1)
+		}
+
+		w.Header().Set("Link", links)
+		fmt.Fprintf(w, `[{"id": "%d-a"}, {"id": "%d-b"}]`, page, page)
+	}))
+
+	return ts, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return maxInFlight
+	}
+}
+
+func TestCollectionCollectParallel(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, maxInFlight := newPagedHostsServer(6, 0)
+	defer ts.Close()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL))
+	collection := NewCollection[Host](client, "/hosts")
+
+	list, err := collection.CollectParallel(context.TODO(), 3)
+	g.Expect(err).To(BeNil())
+
+	var ids []string
+	for _, host := range list {
+		ids = append(ids, host.ID)
+	}
+
+	g.Expect(ids).To(Equal([]string{"1-a", "1-b", "2-a", "2-b", "3-a", "3-b", "4-a", "4-b", "5-a", "5-b", "6-a", "6-b"}))
+	g.Expect(maxInFlight()).To(BeNumerically(">", 1))
+	g.Expect(maxInFlight()).To(BeNumerically("<=", 3))
+	g.Expect(collection.HasNextPage()).To(BeFalse())
+
+	page, err := collection.List(context.TODO())
+	g.Expect(err).To(BeNil())
+	g.Expect(page[0].ID).To(Equal("6-a"))
+}
+
+func TestCollectionCollectParallelError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, _ := newPagedHostsServer(6, 3)
+	defer ts.Close()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL))
+	collection := NewCollection[Host](client, "/hosts")
+
+	_, err := collection.CollectParallel(context.TODO(), 2)
+	g.Expect(err).To(BeAssignableToTypeOf(&InternalServerError{}))
+}
+
+func TestCollectionCollectParallelWithoutLastRel(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=2&per_page=2>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "a"}, {"id": "b"}]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithRequestParams(`page=2&per_page=2`).
+		WithResponseBodyStubInline(`[{"id": "c"}]`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	collection := NewCollection[Host](client, "/hosts")
+
+	list, err := collection.CollectParallel(context.TODO(), 0)
+	g.Expect(err).To(BeNil())
+	g.Expect(list).To(HaveLen(3))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestCollectionClone(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithRequestParams(`per_page=10&type=sbm_server`).
+		WithResponseBodyStubInline(`[{"id": "a"}]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithRequestParams(`per_page=10`).
+		WithResponseBodyStubInline(`[{"id": "b"}]`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	base := NewCollection[Host](client, "/hosts").SetPerPage(10)
+	sbm := base.Clone().WithFilter(HostListFilter{Type: "sbm_server"})
+
+	list, err := sbm.List(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(list[0].ID).To(Equal("a"))
+	g.Expect(base.IsClean()).To(BeTrue())
+
+	list, err = base.List(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(list[0].ID).To(Equal("b"))
+
+	clone := base.Clone()
+	g.Expect(clone.IsClean()).To(BeFalse())
+
+	list, err = clone.List(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(list[0].ID).To(Equal("b"))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestCollectionFetch(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, _ := newPagedHostsServer(4, 0)
+	defer ts.Close()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL))
+	collection := NewCollection[Host](client, "/hosts").SetPerPage(2)
+
+	page, err := collection.Fetch(context.TODO(), 3)
+	g.Expect(err).To(BeNil())
+	g.Expect(page.Number).To(Equal(3))
+	g.Expect(page.Items).To(HaveLen(2))
+	g.Expect(page.Items[0].ID).To(Equal("3-a"))
+	g.Expect(page.HasNextPage()).To(BeTrue())
+	g.Expect(collection.IsClean()).To(BeTrue())
+
+	page, err = collection.Fetch(context.TODO(), 4)
+	g.Expect(err).To(BeNil())
+	g.Expect(page.HasNextPage()).To(BeFalse())
+
+	page, err = collection.Fetch(context.TODO(), 0)
+	g.Expect(err).To(BeNil())
+	g.Expect(page.Number).To(Equal(1))
+	g.Expect(page.Items[0].ID).To(Equal("1-a"))
+
+	_, err = NewCollection[InvoiceList](client, "/billing/invoices").
+		WithFilter(InvoiceListFilter{DateFrom: time.Now(), DateTo: time.Now().AddDate(0, 0, -1)}).
+		Fetch(context.TODO(), 1)
+	g.Expect(err).To(MatchError("Collection filter error: DateFrom is after DateTo"))
+}
+
+func TestCollectionConcurrentUse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, _ := newPagedHostsServer(3, 0)
+	defer ts.Close()
+
+	client := NewClientWithOptions("token", WithBaseURL(ts.URL))
+	collection := NewCollection[Host](client, "/hosts").SetPerPage(2)
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 32)
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			ctx := context.TODO()
+
+			if _, err := collection.List(ctx); err != nil {
+				errs <- err
+			}
+
+			collection.SetParam("search_pattern", "")
+			collection.HasNextPage()
+
+			if _, err := collection.Fetch(ctx, i%3+1); err != nil {
+				errs <- err
+			}
+
+			if _, err := collection.Clone().Collect(ctx); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		g.Expect(err).To(BeNil())
+	}
+}
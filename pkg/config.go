@@ -0,0 +1,252 @@
+package serverscom
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by NewClientFromEnvironment
+const (
+	// EnvToken overrides a token of the profile
+	EnvToken = "SC_TOKEN"
+
+	// EnvEndpoint overrides an endpoint of the profile
+	EnvEndpoint = "SC_ENDPOINT"
+
+	// EnvProfile sets a profile name, by default the default_profile of the config file or "default" is used
+	EnvProfile = "SC_PROFILE"
+
+	// EnvConfig sets a config file path, by default DefaultConfigPath is used
+	EnvConfig = "SC_CONFIG"
+)
+
+const defaultProfileName = "default"
+
+// Config represents a config file with client profiles.
+//
+//	default_profile: production
+//	profiles:
+//	  production:
+//	    token_command: pass show serverscom
+//	    token_command_timeout: 10s
+//	    endpoint: https://api.servers.com/v1
+//	    user_agent: my-tool
+//	    retry:
+//	      max_attempts: 4
+//	      min_backoff: 500ms
+//	      max_backoff: 30s
+//	    rate_limit:
+//	      requests_per_second: 5
+//	      burst: 10
+type Config struct {
+	DefaultProfile string              `yaml:"default_profile"`
+	Profiles       map[string]*Profile `yaml:"profiles"`
+}
+
+// Profile represents client settings
+type Profile struct {
+	// Token is an API token, it takes precedence over TokenCommand
+	Token string `yaml:"token"`
+
+	// TokenCommand is a command which prints an API token, see CommandTokenSource
+	TokenCommand string `yaml:"token_command"`
+
+	// TokenCommandTimeout limits the run time of TokenCommand, by default: 30s
+	TokenCommandTimeout time.Duration `yaml:"token_command_timeout"`
+
+	Endpoint  string `yaml:"endpoint"`
+	UserAgent string `yaml:"user_agent"`
+
+	Retry     *ProfileRetry     `yaml:"retry"`
+	RateLimit *ProfileRateLimit `yaml:"rate_limit"`
+}
+
+// ProfileRetry represents retry settings of a profile, missing fields are taken from DefaultRetryPolicy
+type ProfileRetry struct {
+	MaxAttempts int           `yaml:"max_attempts"`
+	MinBackoff  time.Duration `yaml:"min_backoff"`
+	MaxBackoff  time.Duration `yaml:"max_backoff"`
+}
+
+// ProfileRateLimit represents rate limit settings of a profile
+type ProfileRateLimit struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int     `yaml:"burst"`
+}
+
+// DefaultConfigPath returns a path of the config file: $XDG_CONFIG_HOME/serverscom/config.yaml
+// or ~/.config/serverscom/config.yaml
+func DefaultConfigPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+
+		dir = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(dir, "serverscom", "config.yaml"), nil
+}
+
+// LoadConfig reads a config file
+func LoadConfig(path string) (*Config, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := new(Config)
+
+	if err := yaml.Unmarshal(contents, config); err != nil {
+		return nil, fmt.Errorf("Config file %s is invalid: %w", path, err)
+	}
+
+	return config, nil
+}
+
+// Profile returns a profile by name, an empty name means the default profile
+func (c *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = c.DefaultProfile
+	}
+
+	if name == "" {
+		name = defaultProfileName
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok || profile == nil {
+		return nil, fmt.Errorf("Profile %s is not found", name)
+	}
+
+	return profile, nil
+}
+
+// ProfileFromEnvironment resolves a profile: environment variables take precedence over the config file.
+//
+// A missing config file isn't an error unless a profile is set by SC_PROFILE or a file by SC_CONFIG.
+func ProfileFromEnvironment() (*Profile, error) {
+	profileName := os.Getenv(EnvProfile)
+	path := os.Getenv(EnvConfig)
+	explicit := profileName != "" || path != ""
+
+	if path == "" {
+		var err error
+
+		if path, err = DefaultConfigPath(); err != nil && explicit {
+			return nil, err
+		}
+	}
+
+	profile := new(Profile)
+
+	if path != "" {
+		config, err := LoadConfig(path)
+
+		switch {
+		case err == nil:
+			found, err := config.Profile(profileName)
+			if err != nil && explicit {
+				return nil, err
+			}
+
+			if err == nil {
+				profile = found
+			}
+		case !errors.Is(err, os.ErrNotExist) || explicit:
+			return nil, err
+		}
+	}
+
+	resolved := *profile
+
+	if token := os.Getenv(EnvToken); token != "" {
+		resolved.Token = token
+		resolved.TokenCommand = ""
+	}
+
+	if endpoint := os.Getenv(EnvEndpoint); endpoint != "" {
+		resolved.Endpoint = endpoint
+	}
+
+	return &resolved, nil
+}
+
+// Options returns client options built from the profile, settings which aren't set are left default
+func (p *Profile) Options() []Option {
+	var opts []Option
+
+	switch {
+	case p.Token != "":
+		opts = append(opts, WithTokenSource(StaticTokenSource(p.Token)))
+	case p.TokenCommand != "":
+		opts = append(opts, WithTokenSource(CommandTokenSourceWithTimeout(p.TokenCommand, p.TokenCommandTimeout)))
+	}
+
+	if p.Endpoint != "" {
+		opts = append(opts, WithBaseURL(p.Endpoint))
+	}
+
+	if p.UserAgent != "" {
+		opts = append(opts, WithUserAgent(p.UserAgent))
+	}
+
+	if p.Retry != nil {
+		policy := DefaultRetryPolicy()
+
+		if p.Retry.MaxAttempts > 0 {
+			policy.MaxAttempts = p.Retry.MaxAttempts
+		}
+
+		if p.Retry.MinBackoff > 0 {
+			policy.MinBackoff = p.Retry.MinBackoff
+		}
+
+		if p.Retry.MaxBackoff > 0 {
+			policy.MaxBackoff = p.Retry.MaxBackoff
+		}
+
+		opts = append(opts, WithRetryPolicy(policy))
+	}
+
+	if p.RateLimit != nil {
+		opts = append(opts, WithRateLimit(&RateLimit{
+			RequestsPerSecond: p.RateLimit.RequestsPerSecond,
+			Burst:             p.RateLimit.Burst,
+		}))
+	}
+
+	return opts
+}
+
+// NewClientFromEnvironment builds a new client configured by ProfileFromEnvironment,
+// passed options are applied after the profile ones. It returns an error when neither
+// the profile nor the options set a token.
+func NewClientFromEnvironment(opts ...Option) (*Client, error) {
+	profile, err := ProfileFromEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("Client config error: %w", err)
+	}
+
+	opts = append(profile.Options(), opts...)
+
+	var options clientOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.tokenSource == nil {
+		return nil, fmt.Errorf("Client config error: token is not set, use %s, a config file or WithTokenSource", EnvToken)
+	}
+
+	return NewClientWithOptions("", opts...), nil
+}
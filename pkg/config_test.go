@@ -0,0 +1,168 @@
+package serverscom
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+const testConfig = `
+default_profile: production
+profiles:
+  production:
+    token: production_token
+    endpoint: https://api.servers.com/v1
+    user_agent: my-tool
+    retry:
+      max_attempts: 2
+      min_backoff: 100ms
+    rate_limit:
+      requests_per_second: 5
+      burst: 10
+  staging:
+    token_command: echo staging_token
+    endpoint: https://staging.servers.com/v1
+`
+
+func writeTestConfig(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	if err := os.WriteFile(path, []byte(testConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func clearConfigEnv(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	for _, name := range []string{EnvToken, EnvEndpoint, EnvProfile, EnvConfig} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	config, err := LoadConfig(writeTestConfig(t))
+	g.Expect(err).To(BeNil())
+
+	profile, err := config.Profile("")
+	g.Expect(err).To(BeNil())
+	g.Expect(profile).To(Equal(&Profile{
+		Token:     "production_token",
+		Endpoint:  "https://api.servers.com/v1",
+		UserAgent: "my-tool",
+		Retry: &ProfileRetry{
+			MaxAttempts: 2,
+			MinBackoff:  100 * time.Millisecond,
+		},
+		RateLimit: &ProfileRateLimit{
+			RequestsPerSecond: 5,
+			Burst:             10,
+		},
+	}))
+
+	_, err = config.Profile("missing")
+	g.Expect(err).To(MatchError("Profile missing is not found"))
+}
+
+func TestDefaultConfigPath(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Setenv("XDG_CONFIG_HOME", "/etc/xdg")
+
+	path, err := DefaultConfigPath()
+	g.Expect(err).To(BeNil())
+	g.Expect(path).To(Equal("/etc/xdg/serverscom/config.yaml"))
+
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("HOME", "/home/user")
+
+	path, err = DefaultConfigPath()
+	g.Expect(err).To(BeNil())
+	g.Expect(path).To(Equal("/home/user/.config/serverscom/config.yaml"))
+}
+
+func TestProfileFromEnvironmentPrecedence(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	clearConfigEnv(t)
+	t.Setenv(EnvConfig, writeTestConfig(t))
+
+	profile, err := ProfileFromEnvironment()
+	g.Expect(err).To(BeNil())
+	g.Expect(profile.Token).To(Equal("production_token"))
+
+	t.Setenv(EnvProfile, "staging")
+
+	profile, err = ProfileFromEnvironment()
+	g.Expect(err).To(BeNil())
+	g.Expect(profile.TokenCommand).To(Equal("echo staging_token"))
+	g.Expect(profile.Endpoint).To(Equal("https://staging.servers.com/v1"))
+
+	t.Setenv(EnvToken, "env_token")
+	t.Setenv(EnvEndpoint, "https://env.servers.com/v1")
+
+	profile, err = ProfileFromEnvironment()
+	g.Expect(err).To(BeNil())
+	g.Expect(profile.Token).To(Equal("env_token"))
+	g.Expect(profile.TokenCommand).To(BeEmpty())
+	g.Expect(profile.Endpoint).To(Equal("https://env.servers.com/v1"))
+
+	t.Setenv(EnvProfile, "missing")
+
+	_, err = ProfileFromEnvironment()
+	g.Expect(err).To(MatchError("Profile missing is not found"))
+}
+
+func TestNewClientFromEnvironment(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	clearConfigEnv(t)
+
+	_, err := NewClientFromEnvironment()
+	g.Expect(err).To(MatchError("Client config error: token is not set, use SC_TOKEN, a config file or WithTokenSource"))
+
+	t.Setenv(EnvToken, "env_token")
+
+	client, err := NewClientFromEnvironment()
+	g.Expect(err).To(BeNil())
+	g.Expect(client.baseURL).To(Equal(defaultAPIEndpoint))
+	g.Expect(client.token()).To(Equal("env_token"))
+
+	t.Setenv(EnvToken, "")
+	t.Setenv(EnvConfig, writeTestConfig(t))
+
+	client, err = NewClientFromEnvironment(WithUserAgent("override"))
+	g.Expect(err).To(BeNil())
+	g.Expect(client.baseURL).To(Equal("https://api.servers.com/v1"))
+	g.Expect(client.UserAgent).To(Equal("override"))
+	g.Expect(client.retryPolicy.MaxAttempts).To(Equal(2))
+	g.Expect(client.retryPolicy.MinBackoff).To(Equal(100 * time.Millisecond))
+	g.Expect(client.retryPolicy.MaxBackoff).To(Equal(DefaultRetryPolicy().MaxBackoff))
+	g.Expect(client.rateLimiter.all).NotTo(BeNil())
+	g.Expect(client.rateLimiter.all.Burst()).To(Equal(10))
+
+	t.Setenv(EnvProfile, "staging")
+
+	client, err = NewClientFromEnvironment()
+	g.Expect(err).To(BeNil())
+	g.Expect(client.baseURL).To(Equal("https://staging.servers.com/v1"))
+	g.Expect(client.token()).To(Equal("staging_token"))
+}
+
+func TestNewClientFromEnvironmentWithTokenSource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	clearConfigEnv(t)
+
+	client, err := NewClientFromEnvironment(WithTokenSource(StaticTokenSource("option_token")))
+	g.Expect(err).To(BeNil())
+	g.Expect(client.baseURL).To(Equal(defaultAPIEndpoint))
+	g.Expect(client.token()).To(Equal("option_token"))
+}
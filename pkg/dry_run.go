@@ -0,0 +1,95 @@
+package serverscom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// ErrDryRun is returned by services for POST, PUT and DELETE calls which were planned instead of being sent
+var ErrDryRun = errors.New("dry run: request was not sent")
+
+// PlannedOperation represents a mutating call captured in dry-run mode
+type PlannedOperation struct {
+	// Operation is a name of the service method, e.g. Hosts.CreateDedicatedServers
+	Operation string `json:"operation,omitempty"`
+
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Plan collects operations captured in dry-run mode, it's safe for concurrent use
+type Plan struct {
+	mu         sync.Mutex
+	operations []PlannedOperation
+}
+
+// NewPlan builds a new empty plan
+func NewPlan() *Plan {
+	return &Plan{}
+}
+
+// Operations returns captured operations in the order they were called
+func (p *Plan) Operations() []PlannedOperation {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PlannedOperation(nil), p.operations...)
+}
+
+// MarshalJSON exports the plan as a JSON array of operations
+func (p *Plan) MarshalJSON() ([]byte, error) {
+	operations := p.Operations()
+	if operations == nil {
+		operations = []PlannedOperation{}
+	}
+
+	return json.Marshal(operations)
+}
+
+func (p *Plan) add(operation PlannedOperation) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.operations = append(p.operations, operation)
+}
+
+// WithDryRun enables dry-run mode: POST, PUT and DELETE calls aren't sent, they are added to the plan
+// and services return ErrDryRun. GET calls are still sent to the API.
+//
+// Inputs are always validated in dry-run mode, as with WithInputValidation, so invalid calls return
+// ValidationError and aren't planned. Each planned operation is logged at LogLevels.Planned level,
+// by default: info, by the logger set by WithLogger.
+//
+// Known secret fields of bodies, such as SSL private keys, are redacted in planned operations and logs.
+func WithDryRun(plan *Plan) Option {
+	return func(o *clientOptions) {
+		o.dryRunPlan = plan
+	}
+}
+
+// planRequest adds a mutating request to the dry-run plan, it reports whether the request was planned
+func (cli *Client) planRequest(ctx context.Context, method, endpointURL string, body []byte) bool {
+	if cli.dryRunPlan == nil || isReadMethod(method) {
+		return false
+	}
+
+	op, _ := operationFromContext(ctx)
+
+	operation := PlannedOperation{
+		Operation: op.Name,
+		Method:    method,
+		URL:       endpointURL,
+	}
+
+	if len(body) > 0 {
+		operation.Body = json.RawMessage(redactBody(body))
+	}
+
+	cli.dryRunPlan.add(operation)
+	cli.logging.logPlannedOperation(ctx, operation)
+
+	return true
+}
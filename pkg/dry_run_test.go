@@ -0,0 +1,130 @@
+package serverscom
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDryRunPlansMutatingCalls(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	plan := NewPlan()
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers/" + serverID).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/hosts/dedicated_servers/get_response.json").
+		BuildWithOptions(WithDryRun(plan))
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	server, err := client.Hosts.GetDedicatedServer(ctx, serverID)
+	g.Expect(err).To(BeNil())
+	g.Expect(server.ID).To(Equal(serverID))
+
+	server, err = client.Hosts.ScheduleReleaseForDedicatedServer(ctx, serverID, ScheduleReleaseInput{ReleaseAfter: "2022-05-24T12:48:00+03:00"})
+	g.Expect(errors.Is(err, ErrDryRun)).To(BeTrue())
+	g.Expect(server).To(BeNil())
+
+	_, err = client.L2Segments.ChangeNetworks(ctx, "y1aKReQG", L2SegmentChangeNetworksInput{Delete: []string{"a", "b"}})
+	g.Expect(err).To(Equal(ErrDryRun))
+
+	err = client.LoadBalancers.DeleteL7LoadBalancer(ctx, "lb")
+	g.Expect(err).To(Equal(ErrDryRun))
+
+	_, err = client.SSLCertificates.CreateCustom(ctx, SSLCertificateCreateCustomInput{
+		Name:       "cert",
+		PublicKey:  testPEM("CERTIFICATE"),
+		PrivateKey: testPEM("PRIVATE KEY"),
+	})
+	g.Expect(err).To(Equal(ErrDryRun))
+
+	g.Expect(ts.Requests).To(BeEmpty())
+
+	operations := plan.Operations()
+	g.Expect(operations).To(HaveLen(4))
+
+	g.Expect(operations[0].Operation).To(Equal("Hosts.ScheduleReleaseForDedicatedServer"))
+	g.Expect(operations[0].Method).To(Equal("POST"))
+	g.Expect(operations[0].URL).To(Equal(ts.Server.URL + "/v1/hosts/dedicated_servers/" + serverID + "/schedule_release"))
+	g.Expect(operations[0].Body).To(MatchJSON(`{"release_after": "2022-05-24T12:48:00+03:00"}`))
+
+	g.Expect(operations[1].Operation).To(Equal("L2Segments.ChangeNetworks"))
+	g.Expect(operations[1].Method).To(Equal("PUT"))
+	g.Expect(operations[1].Body).To(MatchJSON(`{"delete": ["a", "b"]}`))
+
+	g.Expect(operations[2].Operation).To(Equal("LoadBalancers.DeleteL7LoadBalancer"))
+	g.Expect(operations[2].Method).To(Equal("DELETE"))
+	g.Expect(operations[2].Body).To(BeNil())
+
+	g.Expect(string(operations[3].Body)).NotTo(ContainSubstring("PRIVATE KEY"))
+	g.Expect(operations[3].Body).To(MatchJSON(`{"name": "cert", "public_key": "` + strings.ReplaceAll(testPEM("CERTIFICATE"), "\n", `\n`) + `", "private_key": "[REDACTED]"}`))
+}
+
+func testPEM(blockType string) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: []byte("test")}))
+}
+
+func TestDryRunValidatesAndLogsOperations(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	logger, buf := newTestLogger(slog.LevelInfo)
+
+	plan := NewPlan()
+	client := NewClientWithOptions("token", WithBaseURL("http://dry.run/v1"), WithDryRun(plan), WithLogger(logger))
+
+	ctx := context.TODO()
+
+	_, err := client.SSHKeys.Create(ctx, SSHKeyCreateInput{Name: "key"})
+	g.Expect(err).To(BeAssignableToTypeOf(&ValidationError{}))
+	g.Expect(plan.Operations()).To(BeEmpty())
+	g.Expect(buf.Len()).To(BeZero())
+
+	_, err = client.SSLCertificates.CreateCustom(ctx, SSLCertificateCreateCustomInput{
+		Name:       "cert",
+		PublicKey:  testPEM("CERTIFICATE"),
+		PrivateKey: testPEM("PRIVATE KEY"),
+	})
+	g.Expect(err).To(Equal(ErrDryRun))
+	g.Expect(plan.Operations()).To(HaveLen(1))
+	g.Expect(buf.String()).NotTo(ContainSubstring("PRIVATE KEY"))
+
+	records := decodeLogRecords(g, buf)
+	g.Expect(records).To(HaveLen(1))
+	g.Expect(records[0]["level"]).To(Equal("INFO"))
+	g.Expect(records[0]["msg"]).To(Equal("serverscom planned operation"))
+	g.Expect(records[0]["operation"]).To(Equal("SSLCertificates.CreateCustom"))
+	g.Expect(records[0]["method"]).To(Equal("POST"))
+	g.Expect(records[0]["url"]).To(Equal("http://dry.run/v1/ssl_certificates/custom"))
+}
+
+func TestDryRunPlanExport(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	plan := NewPlan()
+
+	contents, err := json.Marshal(plan)
+	g.Expect(err).To(BeNil())
+	g.Expect(contents).To(MatchJSON(`[]`))
+
+	client := NewClientWithOptions("token", WithBaseURL("http://dry.run/v1"), WithDryRun(plan))
+
+	g.Expect(client.SSHKeys.Delete(context.TODO(), sshFingerprint)).To(MatchError(ErrDryRun))
+
+	contents, err = json.Marshal(plan)
+	g.Expect(err).To(BeNil())
+	g.Expect(contents).To(MatchJSON(`[{
+		"operation": "SSHKeys.Delete",
+		"method": "DELETE",
+		"url": "http://dry.run/v1/ssh_keys/` + sshFingerprint + `"
+	}]`))
+}
@@ -1,30 +1,169 @@
 package serverscom
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"sort"
+	"syscall"
+	"time"
 )
 
+// Sentinel errors matched by errors.Is against errors returned for API responses with the related status code
+var (
+	ErrBadRequest          = errors.New("bad request")
+	ErrUnauthorized        = errors.New("unauthorized")
+	ErrForbidden           = errors.New("forbidden")
+	ErrNotFound            = errors.New("not found")
+	ErrConflict            = errors.New("conflict")
+	ErrUnprocessableEntity = errors.New("unprocessable entity")
+	ErrRateLimited         = errors.New("rate limited")
+	ErrInternalServerError = errors.New("internal server error")
+	ErrBadGateway          = errors.New("bad gateway")
+	ErrServiceUnavailable  = errors.New("service unavailable")
+	ErrGatewayTimeout      = errors.New("gateway timeout")
+)
+
+var statusSentinels = map[int]error{
+	400: ErrBadRequest,
+	401: ErrUnauthorized,
+	403: ErrForbidden,
+	404: ErrNotFound,
+	409: ErrConflict,
+	422: ErrUnprocessableEntity,
+	429: ErrRateLimited,
+	500: ErrInternalServerError,
+	502: ErrBadGateway,
+	503: ErrServiceUnavailable,
+	504: ErrGatewayTimeout,
+}
+
+// APIError is implemented by all errors returned for API responses with status code >= 400
+type APIError interface {
+	error
+
+	// APIStatusCode returns the HTTP status code of the response
+	APIStatusCode() int
+
+	// APIErrorCode returns the error code from the response body, e.g. NOT_FOUND
+	APIErrorCode() string
+
+	// APIMessage returns the error message from the response body
+	APIMessage() string
+
+	// APIRequestID returns the request ID assigned by the API
+	APIRequestID() string
+}
+
+// IsNotFound reports whether the error is returned for a 404 response
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict reports whether the error is returned for a 409 response
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsRateLimited reports whether the error is returned for a 429 response
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsRetryable reports whether the failed request may succeed when retried: it's true for 429, 502, 503 and 504
+// responses and transient network errors such as timeouts and reset connections, and false for cancelled
+// requests, exceeded deadlines, certificate and TLS errors.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.APIStatusCode() {
+		case 429, 502, 503, 504:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return isTransientError(err)
+}
+
+// isTransientError reports whether a transport error may go away by itself: timeouts, reset or
+// refused connections, unexpected EOF, dial and read failures. Certificate and TLS protocol errors
+// and any other errors aren't transient.
+func isTransientError(err error) bool {
+	var (
+		certErr          *tls.CertificateVerificationError
+		recordErr        tls.RecordHeaderError
+		unknownAuthority x509.UnknownAuthorityError
+		hostnameErr      x509.HostnameError
+		certInvalidErr   x509.CertificateInvalidError
+		systemRootsErr   x509.SystemRootsError
+		dnsErr           *net.DNSError
+	)
+
+	switch {
+	case errors.As(err, &certErr),
+		errors.As(err, &recordErr),
+		errors.As(err, &unknownAuthority),
+		errors.As(err, &hostnameErr),
+		errors.As(err, &certInvalidErr),
+		errors.As(err, &systemRootsErr):
+		return false
+	case errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+		return false
+	case errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && (opErr.Op == "dial" || opErr.Op == "read")
+}
+
 type responseErrorWrapper struct {
-	Code    string            `json:"code"`
-	Message string            `json:"message"`
-	Errors  map[string]string `json:"errors"`
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Errors  fieldErrors `json:"errors"`
 }
 
-// ParsingError represents any error related to the problem with parsing a body
+// ParsingError represents any error related to the problem with parsing a body of an error response,
+// it matches status sentinels like other errors returned for API responses
 type ParsingError struct {
 	StatusCode   int
 	Body         string
 	ParsingError error
+	RequestID    string
 }
 
-func newParsingError(statusCode int, body string, err error) error {
+func newParsingError(statusCode int, body string, err error, requestID string) error {
 	return &ParsingError{
 		StatusCode:   statusCode,
 		Body:         body,
 		ParsingError: err,
+		RequestID:    requestID,
 	}
 }
 
+// Unwrap returns the error of the body decoding
+func (e *ParsingError) Unwrap() error {
+	return e.ParsingError
+}
+
 func (e *ParsingError) Error() string {
 	return fmt.Sprintf(
 		"Parsing error: %s, for body: %s, with status code: %d",
@@ -34,18 +173,47 @@ func (e *ParsingError) Error() string {
 	)
 }
 
+// Is makes errors.Is match a sentinel related to the status code, if any
+func (e *ParsingError) Is(target error) bool {
+	sentinel, ok := statusSentinels[e.StatusCode]
+
+	return ok && target == sentinel
+}
+
+// APIStatusCode implements APIError
+func (e *ParsingError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError, the code is unknown since the body can't be parsed
+func (e *ParsingError) APIErrorCode() string {
+	return ""
+}
+
+// APIMessage implements APIError, it returns the raw response body
+func (e *ParsingError) APIMessage() string {
+	return e.Body
+}
+
+// APIRequestID implements APIError
+func (e *ParsingError) APIRequestID() string {
+	return e.RequestID
+}
+
 // BadRequestError represents an errors related to 400 response status code
 type BadRequestError struct {
 	StatusCode int
 	ErrorCode  string
 	Message    string
+	RequestID  string
 }
 
-func newBadRequestError(statusCode int, errorCode, message string) error {
+func newBadRequestError(statusCode int, errorCode, message, requestID string) error {
 	return &BadRequestError{
 		StatusCode: statusCode,
 		ErrorCode:  errorCode,
 		Message:    message,
+		RequestID:  requestID,
 	}
 }
 
@@ -53,18 +221,45 @@ func (e *BadRequestError) Error() string {
 	return fmt.Sprintf("Bad request: %s", e.Message)
 }
 
+// Is makes errors.Is match ErrBadRequest
+func (e *BadRequestError) Is(target error) bool {
+	return target == ErrBadRequest
+}
+
+// APIStatusCode implements APIError
+func (e *BadRequestError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *BadRequestError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *BadRequestError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *BadRequestError) APIRequestID() string {
+	return e.RequestID
+}
+
 // UnauthorizedError represents an errors related to 401 response status code
 type UnauthorizedError struct {
 	StatusCode int
 	ErrorCode  string
 	Message    string
+	RequestID  string
 }
 
-func newUnauthorizedError(statusCode int, errorCode, message string) error {
+func newUnauthorizedError(statusCode int, errorCode, message, requestID string) error {
 	return &UnauthorizedError{
 		StatusCode: statusCode,
 		ErrorCode:  errorCode,
 		Message:    message,
+		RequestID:  requestID,
 	}
 }
 
@@ -72,18 +267,45 @@ func (e *UnauthorizedError) Error() string {
 	return fmt.Sprintf("Unauthorized: %s", e.Message)
 }
 
+// Is makes errors.Is match ErrUnauthorized
+func (e *UnauthorizedError) Is(target error) bool {
+	return target == ErrUnauthorized
+}
+
+// APIStatusCode implements APIError
+func (e *UnauthorizedError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *UnauthorizedError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *UnauthorizedError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *UnauthorizedError) APIRequestID() string {
+	return e.RequestID
+}
+
 // ForbiddenError represents an errors related to 403 response status code
 type ForbiddenError struct {
 	StatusCode int
 	ErrorCode  string
 	Message    string
+	RequestID  string
 }
 
-func newForbiddenError(statusCode int, errorCode, message string) error {
+func newForbiddenError(statusCode int, errorCode, message, requestID string) error {
 	return &ForbiddenError{
 		StatusCode: statusCode,
 		ErrorCode:  errorCode,
 		Message:    message,
+		RequestID:  requestID,
 	}
 }
 
@@ -91,18 +313,45 @@ func (e *ForbiddenError) Error() string {
 	return fmt.Sprintf("Forbidden: %s", e.Message)
 }
 
+// Is makes errors.Is match ErrForbidden
+func (e *ForbiddenError) Is(target error) bool {
+	return target == ErrForbidden
+}
+
+// APIStatusCode implements APIError
+func (e *ForbiddenError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *ForbiddenError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *ForbiddenError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *ForbiddenError) APIRequestID() string {
+	return e.RequestID
+}
+
 // NotFoundError represents an errors related to 404 response status code
 type NotFoundError struct {
 	StatusCode int
 	ErrorCode  string
 	Message    string
+	RequestID  string
 }
 
-func newNotFoundError(statusCode int, errorCode, message string) error {
+func newNotFoundError(statusCode int, errorCode, message, requestID string) error {
 	return &NotFoundError{
 		StatusCode: statusCode,
 		ErrorCode:  errorCode,
 		Message:    message,
+		RequestID:  requestID,
 	}
 }
 
@@ -110,18 +359,45 @@ func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("Not found: %s", e.Message)
 }
 
+// Is makes errors.Is match ErrNotFound
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// APIStatusCode implements APIError
+func (e *NotFoundError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *NotFoundError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *NotFoundError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *NotFoundError) APIRequestID() string {
+	return e.RequestID
+}
+
 // ConflictError represents an errors related to 409 response status code
 type ConflictError struct {
 	StatusCode int
 	ErrorCode  string
 	Message    string
+	RequestID  string
 }
 
-func newConflictError(statusCode int, errorCode, message string) error {
+func newConflictError(statusCode int, errorCode, message, requestID string) error {
 	return &ConflictError{
 		StatusCode: statusCode,
 		ErrorCode:  errorCode,
 		Message:    message,
+		RequestID:  requestID,
 	}
 }
 
@@ -129,20 +405,52 @@ func (e *ConflictError) Error() string {
 	return fmt.Sprintf("Conflict: %s", e.Message)
 }
 
+// Is makes errors.Is match ErrConflict
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// APIStatusCode implements APIError
+func (e *ConflictError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *ConflictError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *ConflictError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *ConflictError) APIRequestID() string {
+	return e.RequestID
+}
+
 // UnprocessableEntityError represents an errors related to 422 response status code
 type UnprocessableEntityError struct {
 	StatusCode int
 	ErrorCode  string
 	Message    string
-	Errors     map[string]string
+	RequestID  string
+
+	// Errors maps field paths to messages, several messages of a field are joined with "; "
+	Errors map[string]string
+
+	fieldErrors []FieldError
 }
 
-func newUnprocessableEntityError(statusCode int, errorCode, message string, errors map[string]string) error {
+func newUnprocessableEntityError(statusCode int, errorCode, message string, errors fieldErrors, requestID string, input interface{}) error {
 	return &UnprocessableEntityError{
-		StatusCode: statusCode,
-		ErrorCode:  errorCode,
-		Message:    message,
-		Errors:     errors,
+		StatusCode:  statusCode,
+		ErrorCode:   errorCode,
+		Message:     message,
+		Errors:      errors.messages(),
+		RequestID:   requestID,
+		fieldErrors: errors.withInput(input),
 	}
 }
 
@@ -150,21 +458,293 @@ func (e *UnprocessableEntityError) Error() string {
 	return fmt.Sprintf("Unprocessable entity: %s, with errors: %v", e.Message, e.Errors)
 }
 
+// Is makes errors.Is match ErrUnprocessableEntity
+func (e *UnprocessableEntityError) Is(target error) bool {
+	return target == ErrUnprocessableEntity
+}
+
+// APIStatusCode implements APIError
+func (e *UnprocessableEntityError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *UnprocessableEntityError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *UnprocessableEntityError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *UnprocessableEntityError) APIRequestID() string {
+	return e.RequestID
+}
+
+// FieldErrors returns errors of each field sorted by path, GoField is set when the error is returned
+// by a method called with an input struct and the path matches its json tags
+func (e *UnprocessableEntityError) FieldErrors() []FieldError {
+	if e.fieldErrors != nil {
+		return e.fieldErrors
+	}
+
+	var result []FieldError
+
+	for path, message := range e.Errors {
+		result = append(result, FieldError{Path: path, Messages: []string{message}})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result
+}
+
 // InternalServerError represents an errors related to 500 response status code
 type InternalServerError struct {
 	StatusCode int
 	ErrorCode  string
 	Message    string
+	RequestID  string
 }
 
-func newInternalServerError(statusCode int, errorCode, message string) error {
+func newInternalServerError(statusCode int, errorCode, message, requestID string) error {
 	return &InternalServerError{
 		StatusCode: statusCode,
 		ErrorCode:  errorCode,
 		Message:    message,
+		RequestID:  requestID,
 	}
 }
 
 func (e *InternalServerError) Error() string {
 	return fmt.Sprintf("Internal server error: %s", e.Message)
 }
+
+// Is makes errors.Is match ErrInternalServerError
+func (e *InternalServerError) Is(target error) bool {
+	return target == ErrInternalServerError
+}
+
+// APIStatusCode implements APIError
+func (e *InternalServerError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *InternalServerError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *InternalServerError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *InternalServerError) APIRequestID() string {
+	return e.RequestID
+}
+
+// TooManyRequestsError represents an errors related to 429 response status code
+type TooManyRequestsError struct {
+	StatusCode int
+	ErrorCode  string
+	Message    string
+	RequestID  string
+
+	// RetryAfter is a delay parsed from Retry-After header, it's zero when the header is missing
+	RetryAfter time.Duration
+}
+
+func newTooManyRequestsError(statusCode int, errorCode, message, requestID string, retryAfter time.Duration) error {
+	return &TooManyRequestsError{
+		StatusCode: statusCode,
+		ErrorCode:  errorCode,
+		Message:    message,
+		RequestID:  requestID,
+		RetryAfter: retryAfter,
+	}
+}
+
+func (e *TooManyRequestsError) Error() string {
+	return fmt.Sprintf("Too many requests: %s, retry after: %s", e.Message, e.RetryAfter)
+}
+
+// Is makes errors.Is match ErrRateLimited
+func (e *TooManyRequestsError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// APIStatusCode implements APIError
+func (e *TooManyRequestsError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *TooManyRequestsError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *TooManyRequestsError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *TooManyRequestsError) APIRequestID() string {
+	return e.RequestID
+}
+
+// ServiceUnavailableError represents an errors related to 503 response status code, e.g. during maintenance windows
+type ServiceUnavailableError struct {
+	StatusCode int
+	ErrorCode  string
+	Message    string
+	RequestID  string
+
+	// RetryAfter is a delay parsed from Retry-After header, it's zero when the header is missing
+	RetryAfter time.Duration
+}
+
+func newServiceUnavailableError(statusCode int, errorCode, message, requestID string, retryAfter time.Duration) error {
+	return &ServiceUnavailableError{
+		StatusCode: statusCode,
+		ErrorCode:  errorCode,
+		Message:    message,
+		RequestID:  requestID,
+		RetryAfter: retryAfter,
+	}
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	return fmt.Sprintf("Service unavailable: %s", e.Message)
+}
+
+// Is makes errors.Is match ErrServiceUnavailable
+func (e *ServiceUnavailableError) Is(target error) bool {
+	return target == ErrServiceUnavailable
+}
+
+// APIStatusCode implements APIError
+func (e *ServiceUnavailableError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *ServiceUnavailableError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *ServiceUnavailableError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *ServiceUnavailableError) APIRequestID() string {
+	return e.RequestID
+}
+
+// GatewayError represents an errors related to 502 and 504 response status codes
+type GatewayError struct {
+	StatusCode int
+	ErrorCode  string
+	Message    string
+	RequestID  string
+}
+
+func newGatewayError(statusCode int, errorCode, message, requestID string) error {
+	return &GatewayError{
+		StatusCode: statusCode,
+		ErrorCode:  errorCode,
+		Message:    message,
+		RequestID:  requestID,
+	}
+}
+
+func (e *GatewayError) Error() string {
+	return fmt.Sprintf("Gateway error: %d, %s", e.StatusCode, e.Message)
+}
+
+// Is makes errors.Is match ErrBadGateway or ErrGatewayTimeout depending on the status code
+func (e *GatewayError) Is(target error) bool {
+	return target == statusSentinels[e.StatusCode]
+}
+
+// APIStatusCode implements APIError
+func (e *GatewayError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *GatewayError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *GatewayError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *GatewayError) APIRequestID() string {
+	return e.RequestID
+}
+
+// HTTPError represents an errors related to response status codes without a dedicated error type
+type HTTPError struct {
+	StatusCode int
+	ErrorCode  string
+	Message    string
+	Errors     map[string]string
+	RequestID  string
+
+	// Body is the raw response body
+	Body string
+}
+
+func newHTTPError(statusCode int, responseError responseErrorWrapper, requestID, body string) error {
+	return &HTTPError{
+		StatusCode: statusCode,
+		ErrorCode:  responseError.Code,
+		Message:    responseError.Message,
+		Errors:     responseError.Errors.messages(),
+		RequestID:  requestID,
+		Body:       body,
+	}
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("Unexpected response code: %d, with body: %s", e.StatusCode, e.Body)
+}
+
+// Is makes errors.Is match a sentinel related to the status code, if any
+func (e *HTTPError) Is(target error) bool {
+	sentinel, ok := statusSentinels[e.StatusCode]
+
+	return ok && target == sentinel
+}
+
+// APIStatusCode implements APIError
+func (e *HTTPError) APIStatusCode() int {
+	return e.StatusCode
+}
+
+// APIErrorCode implements APIError
+func (e *HTTPError) APIErrorCode() string {
+	return e.ErrorCode
+}
+
+// APIMessage implements APIError
+func (e *HTTPError) APIMessage() string {
+	return e.Message
+}
+
+// APIRequestID implements APIError
+func (e *HTTPError) APIRequestID() string {
+	return e.RequestID
+}
@@ -0,0 +1,265 @@
+package serverscom
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestErrorsMatchSentinels(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{"X-Request-Id": "req-404"}).
+		WithResponseBodyStubInline(`{"code": "NOT_FOUND", "message": "SSH key not found"}`).
+		WithResponseCode(404).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("DELETE").
+		WithResponseBodyStubInline(`{"code": "CONFLICT", "message": "SSH key is in use"}`).
+		WithResponseCode(409).
+		Build()
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+	g.Expect(errors.Is(err, ErrConflict)).To(BeFalse())
+	g.Expect(IsNotFound(err)).To(BeTrue())
+	g.Expect(IsRetryable(err)).To(BeFalse())
+
+	var apiErr APIError
+	g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+	g.Expect(apiErr.APIStatusCode()).To(Equal(404))
+	g.Expect(apiErr.APIErrorCode()).To(Equal("NOT_FOUND"))
+	g.Expect(apiErr.APIMessage()).To(Equal("SSH key not found"))
+	g.Expect(apiErr.APIRequestID()).To(Equal("req-404"))
+
+	err = client.SSHKeys.Delete(ctx, sshFingerprint)
+	g.Expect(IsConflict(err)).To(BeTrue())
+	g.Expect(IsNotFound(err)).To(BeFalse())
+}
+
+func TestErrorsRateLimited(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "TOO_MANY_REQUESTS", "message": "Slow down"}`).
+		WithResponseCode(429).
+		Build()
+
+	defer ts.Close()
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&TooManyRequestsError{}))
+	g.Expect(IsRateLimited(err)).To(BeTrue())
+	g.Expect(IsRetryable(err)).To(BeTrue())
+
+	var apiErr APIError
+	g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+	g.Expect(apiErr.APIErrorCode()).To(Equal("TOO_MANY_REQUESTS"))
+}
+
+func TestErrorsWrapTransportErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		<-r.Context().Done()
+
+		return nil, r.Context().Err()
+	})
+
+	client := NewClientWithOptions("token", WithTransport(transport))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	g.Expect(IsRetryable(err)).To(BeFalse())
+
+	transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return nil, &timeoutError{}
+	})
+
+	client = NewClientWithOptions("token", WithTransport(transport))
+
+	_, err = client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(IsRetryable(err)).To(BeTrue())
+}
+
+func TestParsingErrorUnwrap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`not json`).
+		WithResponseCode(400).
+		Build()
+
+	defer ts.Close()
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+
+	var syntaxErr *json.SyntaxError
+	g.Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+	g.Expect(errors.Is(err, ErrBadRequest)).To(BeTrue())
+
+	var apiErr APIError
+	g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+	g.Expect(apiErr.APIStatusCode()).To(Equal(400))
+	g.Expect(apiErr.APIMessage()).To(Equal("not json"))
+}
+
+func TestParsingErrorMatchesStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{"Content-Type": "application/json"}).
+		WithResponseBodyStubInline(`<html>Not Found</html>`).
+		WithResponseCode(404).
+		Build()
+
+	defer ts.Close()
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&ParsingError{}))
+	g.Expect(IsNotFound(err)).To(BeTrue())
+	g.Expect(IsRetryable(err)).To(BeFalse())
+}
+
+func TestIsRetryableTransportErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	wrap := func(err error) error {
+		return fmt.Errorf("Client request error: %w", &url.Error{Op: "Get", URL: "https://api.servers.com/v1/ssh_keys", Err: err})
+	}
+
+	for _, err := range []error{
+		&timeoutError{},
+		&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+		&net.OpError{Op: "write", Net: "tcp", Err: syscall.ECONNRESET},
+		&net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection closed")},
+		io.ErrUnexpectedEOF,
+	} {
+		g.Expect(IsRetryable(wrap(err))).To(BeTrue(), err.Error())
+	}
+
+	for _, err := range []error{
+		&tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}},
+		x509.UnknownAuthorityError{},
+		x509.HostnameError{Certificate: &x509.Certificate{}, Host: "api.servers.com"},
+		tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"},
+		&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "api.servers.com", IsNotFound: true}},
+		errors.New("stopped after 10 redirects"),
+		errors.New("recorder is closed"),
+	} {
+		g.Expect(IsRetryable(wrap(err))).To(BeFalse(), err.Error())
+	}
+}
+
+type timeoutError struct{}
+
+func (e *timeoutError) Error() string {
+	return "i/o timeout"
+}
+
+func (e *timeoutError) Timeout() bool {
+	return true
+}
+
+func (e *timeoutError) Temporary() bool {
+	return true
+}
+
+func TestTypedErrorsForUnhandledStatuses(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{"Retry-After": "30"}).
+		WithResponseBodyStubInline(`{"code": "TOO_MANY_REQUESTS", "message": "Slow down"}`).
+		WithResponseCode(429).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{"Retry-After": "120"}).
+		WithResponseBodyStubInline(`{"code": "MAINTENANCE", "message": "Planned maintenance"}`).
+		WithResponseCode(503).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "BAD_GATEWAY", "message": "Upstream failed"}`).
+		WithResponseCode(502).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "GATEWAY_TIMEOUT", "message": "Upstream timed out"}`).
+		WithResponseCode(504).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "GONE", "message": "Removed", "errors": {"id": "is gone"}}`).
+		WithResponseCode(410).
+		Build()
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&TooManyRequestsError{}))
+	g.Expect(err.(*TooManyRequestsError).RetryAfter).To(Equal(30 * time.Second))
+	g.Expect(IsRateLimited(err)).To(BeTrue())
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&ServiceUnavailableError{}))
+	g.Expect(err.(*ServiceUnavailableError).RetryAfter).To(Equal(2 * time.Minute))
+	g.Expect(err.(*ServiceUnavailableError).ErrorCode).To(Equal("MAINTENANCE"))
+	g.Expect(errors.Is(err, ErrServiceUnavailable)).To(BeTrue())
+	g.Expect(IsRetryable(err)).To(BeTrue())
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&GatewayError{}))
+	g.Expect(errors.Is(err, ErrBadGateway)).To(BeTrue())
+	g.Expect(errors.Is(err, ErrGatewayTimeout)).To(BeFalse())
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&GatewayError{}))
+	g.Expect(err.(*GatewayError).StatusCode).To(Equal(504))
+	g.Expect(errors.Is(err, ErrGatewayTimeout)).To(BeTrue())
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&HTTPError{}))
+
+	httpErr := err.(*HTTPError)
+	g.Expect(httpErr.StatusCode).To(Equal(410))
+	g.Expect(httpErr.ErrorCode).To(Equal("GONE"))
+	g.Expect(httpErr.Message).To(Equal("Removed"))
+	g.Expect(httpErr.Errors).To(Equal(map[string]string{"id": "is gone"}))
+	g.Expect(httpErr.Body).To(MatchJSON(`{"code": "GONE", "message": "Removed", "errors": {"id": "is gone"}}`))
+	g.Expect(IsRetryable(err)).To(BeFalse())
+}
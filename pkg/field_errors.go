@@ -0,0 +1,263 @@
+package serverscom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FieldError represents validation errors of a single input field
+type FieldError struct {
+	// Path is a JSON path of the field as reported by the API, e.g. drives.layout[0].partitions[1].size
+	Path string
+
+	// GoField is a path of the related input struct field, e.g. Drives.Layout[0].Partitions[1].Size,
+	// it's empty when the path can't be mapped to the input
+	GoField string
+
+	Messages []string
+}
+
+// fieldErrors decodes the errors object of a response, it accepts a message or a list of messages per field
+// and nested objects and arrays, which are flattened to dotted paths with indexes.
+type fieldErrors []FieldError
+
+func (fe *fieldErrors) UnmarshalJSON(data []byte) error {
+	var value interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	collected := make(map[string][]string)
+	collectFieldErrors(collected, "", value)
+
+	paths := make([]string, 0, len(collected))
+	for path := range collected {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	*fe = make(fieldErrors, 0, len(paths))
+
+	for _, path := range paths {
+		*fe = append(*fe, FieldError{Path: path, Messages: collected[path]})
+	}
+
+	return nil
+}
+
+func collectFieldErrors(collected map[string][]string, path string, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			nestedPath := key
+			if path != "" {
+				nestedPath = path + "." + key
+			}
+
+			collectFieldErrors(collected, nestedPath, nested)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			switch nested.(type) {
+			case map[string]interface{}, []interface{}:
+				collectFieldErrors(collected, path+"["+strconv.Itoa(i)+"]", nested)
+			default:
+				collectFieldErrors(collected, path, nested)
+			}
+		}
+	case nil:
+	default:
+		collected[path] = append(collected[path], strings.TrimSpace(stringifyFieldError(v)))
+	}
+}
+
+func stringifyFieldError(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	contents, _ := json.Marshal(value)
+
+	return string(contents)
+}
+
+// messages returns errors as a flat map, several messages of a field are joined with "; "
+func (fe fieldErrors) messages() map[string]string {
+	if fe == nil {
+		return nil
+	}
+
+	result := make(map[string]string, len(fe))
+
+	for _, fieldError := range fe {
+		result[fieldError.Path] = strings.Join(fieldError.Messages, "; ")
+	}
+
+	return result
+}
+
+// withInput returns fieldErrors with GoField set by matching paths against json tags of the input
+func (fe fieldErrors) withInput(input interface{}) []FieldError {
+	if fe == nil {
+		return nil
+	}
+
+	result := make([]FieldError, len(fe))
+
+	for i, fieldError := range fe {
+		fieldError.Messages = append([]string(nil), fieldError.Messages...)
+
+		if input != nil {
+			fieldError.GoField = goFieldPath(reflect.TypeOf(input), fieldError.Path)
+		}
+
+		result[i] = fieldError
+	}
+
+	return result
+}
+
+// goFieldPath maps a JSON path to a Go field path of the type, it returns an empty string if the path doesn't match
+func goFieldPath(t reflect.Type, path string) string {
+	var goPath strings.Builder
+
+	for _, segment := range splitFieldPath(path) {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		index, isIndex := segment.index()
+
+		switch {
+		case isIndex && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array):
+			goPath.WriteString("[" + strconv.Itoa(index) + "]")
+			t = t.Elem()
+		case t.Kind() == reflect.Map && t.Key().Kind() == reflect.String:
+			goPath.WriteString("[" + strconv.Quote(segment.name) + "]")
+			t = t.Elem()
+		case !isIndex && t.Kind() == reflect.Struct:
+			field, ok := fieldByJSONName(t, segment.name)
+			if !ok {
+				return ""
+			}
+
+			if goPath.Len() > 0 {
+				goPath.WriteString(".")
+			}
+
+			goPath.WriteString(field.Name)
+			t = field.Type
+		default:
+			return ""
+		}
+	}
+
+	return goPath.String()
+}
+
+type fieldPathSegment struct {
+	name    string
+	bracket bool
+}
+
+func (s fieldPathSegment) index() (int, bool) {
+	if !s.bracket {
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(s.name)
+
+	return index, err == nil
+}
+
+// splitFieldPath splits a path like drives.layout[0].partitions[1].size to segments
+func splitFieldPath(path string) []fieldPathSegment {
+	var segments []fieldPathSegment
+
+	for _, part := range strings.Split(path, ".") {
+		for part != "" {
+			open := strings.Index(part, "[")
+			if open == -1 {
+				segments = append(segments, fieldPathSegment{name: part})
+
+				break
+			}
+
+			if open > 0 {
+				segments = append(segments, fieldPathSegment{name: part[:open]})
+			}
+
+			end := strings.Index(part[open:], "]")
+			if end == -1 {
+				segments = append(segments, fieldPathSegment{name: part[open:]})
+
+				break
+			}
+
+			segments = append(segments, fieldPathSegment{name: part[open+1 : open+end], bracket: true})
+			part = part[open+end+1:]
+		}
+	}
+
+	return segments
+}
+
+// fieldByJSONName looks up a struct field by its json name, fields of embedded structs are included
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		tagName, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && tagName == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				if found, ok := fieldByJSONName(embedded, name); ok {
+					return found, true
+				}
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if tagName == name || (tagName == "" && strings.EqualFold(field.Name, name)) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+type inputContextKey struct{}
+
+// withInput attaches a request input to the context, so errors of the response can be mapped to its fields
+func withInput(ctx context.Context, input interface{}) context.Context {
+	return context.WithValue(ctx, inputContextKey{}, input)
+}
+
+func inputFromContext(ctx context.Context) interface{} {
+	return ctx.Value(inputContextKey{})
+}
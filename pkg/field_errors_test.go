@@ -0,0 +1,118 @@
+package serverscom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFieldErrorsMappedToInputFields(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers").
+		WithRequestMethod("POST").
+		WithResponseBodyStubInline(`{
+			"code": "UNPROCESSABLE_ENTITY",
+			"message": "Validation failed",
+			"errors": {
+				"hosts": ["can't be blank"],
+				"drives.layout[0].partitions[1].size": ["must be greater than 0", "must be even"],
+				"uplink_models": {"private": {"id": "is unknown"}},
+				"unknown_field": "is unexpected"
+			}
+		}`).
+		WithResponseCode(422).
+		Build()
+
+	defer ts.Close()
+
+	_, err := client.Hosts.CreateDedicatedServers(context.TODO(), DedicatedServerCreateInput{})
+
+	var validationErr *UnprocessableEntityError
+	g.Expect(errors.As(err, &validationErr)).To(BeTrue())
+	g.Expect(validationErr.Errors).To(Equal(map[string]string{
+		"hosts":                               "can't be blank",
+		"drives.layout[0].partitions[1].size": "must be greater than 0; must be even",
+		"uplink_models.private.id":            "is unknown",
+		"unknown_field":                       "is unexpected",
+	}))
+	g.Expect(validationErr.FieldErrors()).To(Equal([]FieldError{
+		{
+			Path:     "drives.layout[0].partitions[1].size",
+			GoField:  "Drives.Layout[0].Partitions[1].Size",
+			Messages: []string{"must be greater than 0", "must be even"},
+		},
+		{
+			Path:     "hosts",
+			GoField:  "Hosts",
+			Messages: []string{"can't be blank"},
+		},
+		{
+			Path:     "unknown_field",
+			Messages: []string{"is unexpected"},
+		},
+		{
+			Path:     "uplink_models.private.id",
+			GoField:  "UplinkModels.Private.ID",
+			Messages: []string{"is unknown"},
+		},
+	}))
+}
+
+func TestFieldErrorsDecodeNestedArrays(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var decoded fieldErrors
+
+	err := json.Unmarshal([]byte(`{
+		"drives": {"layout": [{}, {"partitions": [null, {"size": ["is too small"]}]}]},
+		"hosts": [{"labels": {"env": "is too long"}}, "must have 1 item"]
+	}`), &decoded)
+	g.Expect(err).To(BeNil())
+
+	g.Expect(decoded.withInput(DedicatedServerCreateInput{})).To(Equal([]FieldError{
+		{
+			Path:     "drives.layout[1].partitions[1].size",
+			GoField:  "Drives.Layout[1].Partitions[1].Size",
+			Messages: []string{"is too small"},
+		},
+		{
+			Path:     "hosts",
+			GoField:  "Hosts",
+			Messages: []string{"must have 1 item"},
+		},
+		{
+			Path:     "hosts[0].labels.env",
+			GoField:  `Hosts[0].Labels["env"]`,
+			Messages: []string{"is too long"},
+		},
+	}))
+}
+
+func TestGoFieldPath(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	inputType := reflect.TypeOf(&DedicatedServerCreateInput{})
+
+	g.Expect(goFieldPath(inputType, "ram_size")).To(Equal("RAMSize"))
+	g.Expect(goFieldPath(inputType, "uplink_models.public.bandwidth_model_id")).To(Equal("UplinkModels.Public.BandwidthModelID"))
+	g.Expect(goFieldPath(inputType, "drives.slots[2].position")).To(Equal("Drives.Slots[2].Position"))
+	g.Expect(goFieldPath(inputType, "drives[0]")).To(BeEmpty())
+	g.Expect(goFieldPath(inputType, "ram_size.value")).To(BeEmpty())
+}
+
+func TestUnprocessableEntityErrorFieldErrorsFromMap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	err := &UnprocessableEntityError{Errors: map[string]string{"name": "is blank", "key": "is invalid"}}
+
+	g.Expect(err.FieldErrors()).To(Equal([]FieldError{
+		{Path: "key", Messages: []string{"is invalid"}},
+		{Path: "name", Messages: []string{"is blank"}},
+	}))
+}
@@ -0,0 +1,188 @@
+package serverscom
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/serverscom/serverscom-go-client/pkg/labels"
+)
+
+const filterDateLayout = "2006-01-02"
+
+// Filter is a typed filter of a collection with K elements, see Collection.WithFilter.
+//
+// Filters are tied to the collection element type, so a filter can't be applied to a collection
+// which doesn't support it. Params without a typed filter can still be set by SetParam.
+type Filter[K any] interface {
+	// Params returns query params of the filter, an empty value means the param isn't set
+	Params() (map[string]string, error)
+
+	appliesTo(K)
+}
+
+// HostListFilter filters Hosts.Collection
+type HostListFilter struct {
+	// Type is a host type: dedicated_server, kubernetes_baremetal_node or sbm_server
+	Type          string
+	LocationID    int64
+	RackID        string
+	LabelSelector labels.Selector
+	Search        string
+}
+
+// Params implements Filter
+func (f HostListFilter) Params() (map[string]string, error) {
+	return map[string]string{
+		"type":            f.Type,
+		"location_id":     formatFilterID(f.LocationID),
+		"rack_id":         f.RackID,
+		labels.QueryParam: f.LabelSelector.String(),
+		"search_pattern":  f.Search,
+	}, nil
+}
+
+func (f HostListFilter) appliesTo(Host) {}
+
+// DedicatedServerListFilter filters Hosts.ListDedicatedServers
+type DedicatedServerListFilter struct {
+	LocationID    int64
+	RackID        string
+	LabelSelector labels.Selector
+	Search        string
+}
+
+// Params implements Filter
+func (f DedicatedServerListFilter) Params() (map[string]string, error) {
+	return map[string]string{
+		"location_id":     formatFilterID(f.LocationID),
+		"rack_id":         f.RackID,
+		labels.QueryParam: f.LabelSelector.String(),
+		"search_pattern":  f.Search,
+	}, nil
+}
+
+func (f DedicatedServerListFilter) appliesTo(DedicatedServer) {}
+
+// KubernetesBaremetalNodeListFilter filters Hosts.ListKubernetesBaremetalNodes
+type KubernetesBaremetalNodeListFilter struct {
+	LocationID    int64
+	LabelSelector labels.Selector
+	Search        string
+}
+
+// Params implements Filter
+func (f KubernetesBaremetalNodeListFilter) Params() (map[string]string, error) {
+	return map[string]string{
+		"location_id":     formatFilterID(f.LocationID),
+		labels.QueryParam: f.LabelSelector.String(),
+		"search_pattern":  f.Search,
+	}, nil
+}
+
+func (f KubernetesBaremetalNodeListFilter) appliesTo(KubernetesBaremetalNode) {}
+
+// SBMServerListFilter filters Hosts.ListSBMServers
+type SBMServerListFilter struct {
+	LocationID    int64
+	LabelSelector labels.Selector
+	Search        string
+}
+
+// Params implements Filter
+func (f SBMServerListFilter) Params() (map[string]string, error) {
+	return map[string]string{
+		"location_id":     formatFilterID(f.LocationID),
+		labels.QueryParam: f.LabelSelector.String(),
+		"search_pattern":  f.Search,
+	}, nil
+}
+
+func (f SBMServerListFilter) appliesTo(SBMServer) {}
+
+// CloudInstanceListFilter filters CloudComputingInstances.Collection
+type CloudInstanceListFilter struct {
+	RegionID      int64
+	LabelSelector labels.Selector
+}
+
+// Params implements Filter
+func (f CloudInstanceListFilter) Params() (map[string]string, error) {
+	return map[string]string{
+		"region_id":       formatFilterID(f.RegionID),
+		labels.QueryParam: f.LabelSelector.String(),
+	}, nil
+}
+
+func (f CloudInstanceListFilter) appliesTo(CloudComputingInstance) {}
+
+// InvoiceListFilter filters Invoices.Collection, DateFrom and DateTo are compared by date only
+type InvoiceListFilter struct {
+	Status   string
+	Type     string
+	DateFrom time.Time
+	DateTo   time.Time
+}
+
+// Params implements Filter, it returns an error if DateFrom is after DateTo
+func (f InvoiceListFilter) Params() (map[string]string, error) {
+	dateFrom := formatFilterDate(f.DateFrom)
+	dateTo := formatFilterDate(f.DateTo)
+
+	if dateFrom != "" && dateTo != "" && dateFrom > dateTo {
+		return nil, errors.New("DateFrom is after DateTo")
+	}
+
+	return map[string]string{
+		"status":     f.Status,
+		"type":       f.Type,
+		"start_date": dateFrom,
+		"end_date":   dateTo,
+	}, nil
+}
+
+func (f InvoiceListFilter) appliesTo(InvoiceList) {}
+
+// LabeledResource lists collection elements which have labels and can be filtered by a label selector
+type LabeledResource interface {
+	CloudBlockStorageBackup | CloudBlockStorageVolume | CloudComputingInstance | DedicatedServer |
+		KubernetesBaremetalNode | KubernetesCluster | KubernetesClusterNode | L2Member | L2Segment |
+		LoadBalancer | NetworkPool | Rack | RemoteBlockStorageVolume | SBMServer | SSHKey | SSLCertificate
+}
+
+type labelSelectorFilter[K LabeledResource] struct {
+	selector labels.Selector
+}
+
+// LabelSelectorFilter filters a collection of labeled resources by the selector,
+// the same selector can be used on the client side by labels.Selector.Matches.
+//
+//	client.SSHKeys.Collection().WithFilter(LabelSelectorFilter[SSHKey](labels.MustParse("env=prod")))
+func LabelSelectorFilter[K LabeledResource](selector labels.Selector) Filter[K] {
+	return labelSelectorFilter[K]{selector: selector}
+}
+
+// Params implements Filter
+func (f labelSelectorFilter[K]) Params() (map[string]string, error) {
+	return map[string]string{
+		labels.QueryParam: f.selector.String(),
+	}, nil
+}
+
+func (f labelSelectorFilter[K]) appliesTo(K) {}
+
+func formatFilterID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(id, 10)
+}
+
+func formatFilterDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format(filterDateLayout)
+}
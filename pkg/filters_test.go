@@ -0,0 +1,122 @@
+package serverscom
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/serverscom/serverscom-go-client/pkg/labels"
+)
+
+func TestDedicatedServerListFilter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers").
+		WithRequestMethod("GET").
+		WithRequestParams(`label_selector=env%3Dprod&location_id=1&rack_id=rack&search_pattern=web`).
+		WithResponseBodyStubInline(`[]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts/dedicated_servers").
+		WithRequestMethod("GET").
+		WithRequestParams(`location_id=2&per_page=10`).
+		WithResponseBodyStubInline(`[]`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	collection := client.Hosts.ListDedicatedServers().WithFilter(DedicatedServerListFilter{
+		LocationID:    1,
+		RackID:        "rack",
+		LabelSelector: labels.MustParse("env=prod"),
+		Search:        "web",
+	})
+
+	_, err := collection.List(ctx)
+	g.Expect(err).To(BeNil())
+
+	err = collection.
+		WithFilter(DedicatedServerListFilter{LocationID: 2}).
+		SetPerPage(10).
+		Refresh(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestInvoiceListFilter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/billing/invoices").
+		WithRequestMethod("GET").
+		WithRequestParams(`end_date=2024-01-31&start_date=2024-01-01&status=paid&type=invoice`).
+		WithResponseBodyStubInline(`[]`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	filter := InvoiceListFilter{
+		Status:   "paid",
+		Type:     "invoice",
+		DateFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	_, err := client.Invoices.Collection().WithFilter(filter).List(ctx)
+	g.Expect(err).To(BeNil())
+
+	filter.DateFrom, filter.DateTo = filter.DateTo, filter.DateFrom
+
+	_, err = client.Invoices.Collection().WithFilter(filter).List(ctx)
+	g.Expect(err).To(MatchError("Collection filter error: DateFrom is after DateTo"))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestCloudInstanceListFilter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	params, err := CloudInstanceListFilter{RegionID: 3, LabelSelector: labels.MustParse("tier in (web)")}.Params()
+	g.Expect(err).To(BeNil())
+	g.Expect(params).To(Equal(map[string]string{
+		"region_id":      "3",
+		"label_selector": "tier in (web)",
+	}))
+
+	params, err = CloudInstanceListFilter{}.Params()
+	g.Expect(err).To(BeNil())
+	g.Expect(params).To(Equal(map[string]string{
+		"region_id":      "",
+		"label_selector": "",
+	}))
+}
+
+func TestLabelSelectorFilter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys").
+		WithRequestMethod("GET").
+		WithRequestParams(`label_selector=env%3Dprod%2Ctier+in+%28api%2Cweb%29%2C%21deprecated`).
+		WithResponseBodyStubInline(`[{"name": "a", "labels": {"env": "prod", "tier": "web"}}]`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	selector := labels.MustParse("env=prod,tier in (web,api),!deprecated")
+
+	keys, err := client.SSHKeys.Collection().WithFilter(LabelSelectorFilter[SSHKey](selector)).List(context.TODO())
+	g.Expect(err).To(BeNil())
+	g.Expect(keys).To(HaveLen(1))
+	g.Expect(selector.Matches(keys[0].Labels)).To(BeTrue())
+	g.Expect(ts.Requests).To(BeEmpty())
+}
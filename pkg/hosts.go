@@ -171,12 +171,13 @@ type HostsHandler struct {
 
 // Collection builds a new Collection[Host] interface
 func (h *HostsHandler) Collection() Collection[Host] {
-	return NewCollection[Host](h.client, hostListPath)
+	return NewCollection[Host](h.client, hostListPath).withOperation("Hosts.Collection", hostListPath)
 }
 
 // GetDedicatedServer returns a dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#operation/RetrieveAnExistingDedicatedServer
 func (h *HostsHandler) GetDedicatedServer(ctx context.Context, id string) (*DedicatedServer, error) {
+	ctx = withOperation(ctx, "Hosts.GetDedicatedServer", dedicatedServerPath, id)
 	url := h.client.buildURL(dedicatedServerPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -197,6 +198,7 @@ func (h *HostsHandler) GetDedicatedServer(ctx context.Context, id string) (*Dedi
 // GetKubernetesBaremetalNode returns a kubernetes baremetal node
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Kubernetes-Baremetal-Node/operation/GetAKubernetesBareMetalNode
 func (h *HostsHandler) GetKubernetesBaremetalNode(ctx context.Context, id string) (*KubernetesBaremetalNode, error) {
+	ctx = withOperation(ctx, "Hosts.GetKubernetesBaremetalNode", kubernetesBaremetalNodePath, id)
 	url := h.client.buildURL(kubernetesBaremetalNodePath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -223,6 +225,8 @@ func (h *HostsHandler) CreateDedicatedServers(ctx context.Context, input Dedicat
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.CreateDedicatedServers", dedicatedServerCreatePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(dedicatedServerCreatePath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -248,6 +252,8 @@ func (h *HostsHandler) ScheduleReleaseForDedicatedServer(ctx context.Context, id
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.ScheduleReleaseForDedicatedServer", dedicatedServerScheduleReleasePath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(dedicatedServerScheduleReleasePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -268,6 +274,7 @@ func (h *HostsHandler) ScheduleReleaseForDedicatedServer(ctx context.Context, id
 // AbortReleaseForDedicatedServer aborts scheduled release for the dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/AbortReleaseForADedicatedServer
 func (h *HostsHandler) AbortReleaseForDedicatedServer(ctx context.Context, id string) (*DedicatedServer, error) {
+	ctx = withOperation(ctx, "Hosts.AbortReleaseForDedicatedServer", dedicatedServerAbortReleasePath, id)
 	url := h.client.buildURL(dedicatedServerAbortReleasePath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -288,6 +295,7 @@ func (h *HostsHandler) AbortReleaseForDedicatedServer(ctx context.Context, id st
 // PowerOnDedicatedServer sends power-on command to the dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/PowerOnADedicatedServer
 func (h *HostsHandler) PowerOnDedicatedServer(ctx context.Context, id string) (*DedicatedServer, error) {
+	ctx = withOperation(ctx, "Hosts.PowerOnDedicatedServer", dedicatedServerPowerOnPath, id)
 	url := h.client.buildURL(dedicatedServerPowerOnPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -308,6 +316,7 @@ func (h *HostsHandler) PowerOnDedicatedServer(ctx context.Context, id string) (*
 // PowerOffDedicatedServer sends power-off command to the dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/PowerOffADedicatedServer
 func (h *HostsHandler) PowerOffDedicatedServer(ctx context.Context, id string) (*DedicatedServer, error) {
+	ctx = withOperation(ctx, "Hosts.PowerOffDedicatedServer", dedicatedServerPowerOffPath, id)
 	url := h.client.buildURL(dedicatedServerPowerOffPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -328,6 +337,7 @@ func (h *HostsHandler) PowerOffDedicatedServer(ctx context.Context, id string) (
 // PowerCycleDedicatedServer sends power-cycle command to the dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/PowercycleADedicatedServer
 func (h *HostsHandler) PowerCycleDedicatedServer(ctx context.Context, id string) (*DedicatedServer, error) {
+	ctx = withOperation(ctx, "Hosts.PowerCycleDedicatedServer", dedicatedServerPowerCyclePath, id)
 	url := h.client.buildURL(dedicatedServerPowerCyclePath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -348,6 +358,7 @@ func (h *HostsHandler) PowerCycleDedicatedServer(ctx context.Context, id string)
 // DedicatedServerPowerFeeds returns list of dedicated server power feeds with status
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/ListPowerFeedsForADedicatedServer
 func (h *HostsHandler) DedicatedServerPowerFeeds(ctx context.Context, id string) ([]HostPowerFeed, error) {
+	ctx = withOperation(ctx, "Hosts.DedicatedServerPowerFeeds", hostPowerFeedsListPath, dedicatedServerTypePrefix, id)
 	url := h.client.buildURL(hostPowerFeedsListPath, []interface{}{dedicatedServerTypePrefix, id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -374,6 +385,8 @@ func (h *HostsHandler) CreatePTRRecordForDedicatedServer(ctx context.Context, id
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.CreatePTRRecordForDedicatedServer", dedicatedServerPTRRecordCreatePath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(dedicatedServerPTRRecordCreatePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -394,6 +407,7 @@ func (h *HostsHandler) CreatePTRRecordForDedicatedServer(ctx context.Context, id
 // DeletePTRRecordForDedicatedServer deleted ptr record for the dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeleteAPtrRecordForADedicatedServer
 func (h *HostsHandler) DeletePTRRecordForDedicatedServer(ctx context.Context, hostID string, ptrRecordID string) error {
+	ctx = withOperation(ctx, "Hosts.DeletePTRRecordForDedicatedServer", dedicatedServerPTRRecordDeletePath, hostID, ptrRecordID)
 	url := h.client.buildURL(dedicatedServerPTRRecordDeletePath, []interface{}{hostID, ptrRecordID}...)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -410,6 +424,8 @@ func (h *HostsHandler) ReinstallOperatingSystemForDedicatedServer(ctx context.Co
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.ReinstallOperatingSystemForDedicatedServer", dedicatedServerReinstallPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(dedicatedServerReinstallPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -432,7 +448,7 @@ func (h *HostsHandler) ReinstallOperatingSystemForDedicatedServer(ctx context.Co
 func (h *HostsHandler) DedicatedServerConnections(id string) Collection[HostConnection] {
 	path := h.client.buildPath(hostConnectionListPath, []interface{}{dedicatedServerTypePrefix, id}...)
 
-	return NewCollection[HostConnection](h.client, path)
+	return NewCollection[HostConnection](h.client, path).withOperation("Hosts.DedicatedServerConnections", hostConnectionListPath, dedicatedServerTypePrefix, id)
 }
 
 // DedicatedServerNetworks builds a new Collection[Network] interface
@@ -440,7 +456,7 @@ func (h *HostsHandler) DedicatedServerConnections(id string) Collection[HostConn
 func (h *HostsHandler) DedicatedServerNetworks(id string) Collection[Network] {
 	path := h.client.buildPath(hostNetworksListPath, []interface{}{dedicatedServerTypePrefix, id}...)
 
-	return NewCollection[Network](h.client, path)
+	return NewCollection[Network](h.client, path).withOperation("Hosts.DedicatedServerNetworks", hostNetworksListPath, dedicatedServerTypePrefix, id)
 }
 
 // DedicatedServerDriveSlots builds a new Collection[HostDriveSlot] interface
@@ -448,11 +464,12 @@ func (h *HostsHandler) DedicatedServerNetworks(id string) Collection[Network] {
 func (h *HostsHandler) DedicatedServerDriveSlots(id string) Collection[HostDriveSlot] {
 	path := h.client.buildPath(hostDriveSlotListPath, []interface{}{dedicatedServerTypePrefix, id}...)
 
-	return NewCollection[HostDriveSlot](h.client, path)
+	return NewCollection[HostDriveSlot](h.client, path).withOperation("Hosts.DedicatedServerDriveSlots", hostDriveSlotListPath, dedicatedServerTypePrefix, id)
 }
 
 // KubernetesBaremetalNodePowerFeeds returns list of dedicated server power feeds with status
 func (h *HostsHandler) KubernetesBaremetalNodePowerFeeds(ctx context.Context, id string) ([]HostPowerFeed, error) {
+	ctx = withOperation(ctx, "Hosts.KubernetesBaremetalNodePowerFeeds", hostPowerFeedsListPath, kubernetesBaremetalNodePrefix, id)
 	url := h.client.buildURL(hostPowerFeedsListPath, []interface{}{kubernetesBaremetalNodePrefix, id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -474,14 +491,14 @@ func (h *HostsHandler) KubernetesBaremetalNodePowerFeeds(ctx context.Context, id
 func (h *HostsHandler) KubernetesBaremetalNodeNetworks(id string) Collection[Network] {
 	path := h.client.buildPath(hostNetworksListPath, []interface{}{kubernetesBaremetalNodePrefix, id}...)
 
-	return NewCollection[Network](h.client, path)
+	return NewCollection[Network](h.client, path).withOperation("Hosts.KubernetesBaremetalNodeNetworks", hostNetworksListPath, kubernetesBaremetalNodePrefix, id)
 }
 
 // KubernetesBaremetalNodeDriveSlots builds a new Collection[HostDriveSlot] interface
 func (h *HostsHandler) KubernetesBaremetalNodeDriveSlots(id string) Collection[HostDriveSlot] {
 	path := h.client.buildPath(hostDriveSlotListPath, []interface{}{kubernetesBaremetalNodePrefix, id}...)
 
-	return NewCollection[HostDriveSlot](h.client, path)
+	return NewCollection[HostDriveSlot](h.client, path).withOperation("Hosts.KubernetesBaremetalNodeDriveSlots", hostDriveSlotListPath, kubernetesBaremetalNodePrefix, id)
 }
 
 // DedicatedServerPTRRecords builds a new Collection[PTRRecord] interface
@@ -489,12 +506,13 @@ func (h *HostsHandler) KubernetesBaremetalNodeDriveSlots(id string) Collection[H
 func (h *HostsHandler) DedicatedServerPTRRecords(id string) Collection[PTRRecord] {
 	path := h.client.buildPath(hostPTRsListPath, []interface{}{dedicatedServerTypePrefix, id}...)
 
-	return NewCollection[PTRRecord](h.client, path)
+	return NewCollection[PTRRecord](h.client, path).withOperation("Hosts.DedicatedServerPTRRecords", hostPTRsListPath, dedicatedServerTypePrefix, id)
 }
 
 // GetSBMServer returns an sbm server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Scalable-Baremetal-Server/operation/GetAnSbmServer
 func (h *HostsHandler) GetSBMServer(ctx context.Context, id string) (*SBMServer, error) {
+	ctx = withOperation(ctx, "Hosts.GetSBMServer", sbmServerPath, id)
 	url := h.client.buildURL(sbmServerPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -521,6 +539,8 @@ func (h *HostsHandler) CreateSBMServers(ctx context.Context, input SBMServerCrea
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.CreateSBMServers", sbmServerCreatePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(sbmServerCreatePath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -542,6 +562,7 @@ func (h *HostsHandler) CreateSBMServers(ctx context.Context, input SBMServerCrea
 // This action is irreversible and the removal process will be initiated immediately!!!
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Scalable-Baremetal-Server/operation/ReleaseAnSbmServer
 func (h *HostsHandler) ReleaseSBMServer(ctx context.Context, id string) (*SBMServer, error) {
+	ctx = withOperation(ctx, "Hosts.ReleaseSBMServer", sbmServerPath, id)
 	url := h.client.buildURL(sbmServerPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -568,6 +589,8 @@ func (h *HostsHandler) UpdateDedicatedServer(ctx context.Context, id string, inp
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.UpdateDedicatedServer", dedicatedServerPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(dedicatedServerPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -593,6 +616,8 @@ func (h *HostsHandler) UpdateKubernetesBaremetalNode(ctx context.Context, id str
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.UpdateKubernetesBaremetalNode", kubernetesBaremetalNodePath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(kubernetesBaremetalNodePath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -618,6 +643,8 @@ func (h *HostsHandler) UpdateSBMServer(ctx context.Context, id string, input SBM
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.UpdateSBMServer", sbmServerPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(sbmServerPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -637,6 +664,7 @@ func (h *HostsHandler) UpdateSBMServer(ctx context.Context, id string, input SBM
 // Send a power on command for an SBM server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Scalable-Baremetal-Server/operation/SendAPowerOnCommandForAnSbmServer
 func (h *HostsHandler) PowerOnSBMServer(ctx context.Context, id string) (*SBMServer, error) {
+	ctx = withOperation(ctx, "Hosts.PowerOnSBMServer", sbmServerPowerOnPath, id)
 	url := h.client.buildURL(sbmServerPowerOnPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -656,6 +684,7 @@ func (h *HostsHandler) PowerOnSBMServer(ctx context.Context, id string) (*SBMSer
 // Send a power off command for an SBM server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Scalable-Baremetal-Server/operation/SendAPowerOffCommandForAnSbmServer
 func (h *HostsHandler) PowerOffSBMServer(ctx context.Context, id string) (*SBMServer, error) {
+	ctx = withOperation(ctx, "Hosts.PowerOffSBMServer", sbmServerPowerOffPath, id)
 	url := h.client.buildURL(sbmServerPowerOffPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -675,6 +704,7 @@ func (h *HostsHandler) PowerOffSBMServer(ctx context.Context, id string) (*SBMSe
 // Send a power cycle command for an SBM server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Scalable-Baremetal-Server/operation/SendAPowerCycleCommandForAnSbmServer
 func (h *HostsHandler) PowerCycleSBMServer(ctx context.Context, id string) (*SBMServer, error) {
+	ctx = withOperation(ctx, "Hosts.PowerCycleSBMServer", sbmServerPowerCyclePath, id)
 	url := h.client.buildURL(sbmServerPowerCyclePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -700,6 +730,8 @@ func (h *HostsHandler) ReinstallOperatingSystemForSBMServer(ctx context.Context,
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.ReinstallOperatingSystemForSBMServer", sbmServerReinstallPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(sbmServerReinstallPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -719,6 +751,7 @@ func (h *HostsHandler) ReinstallOperatingSystemForSBMServer(ctx context.Context,
 // Power on a Kubernetes bare metal node
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Kubernetes-Baremetal-Node/operation/PowerOnAKubernetesBareMetalNode
 func (h *HostsHandler) PowerOnKubernetesBaremetalNode(ctx context.Context, id string) (*KubernetesBaremetalNode, error) {
+	ctx = withOperation(ctx, "Hosts.PowerOnKubernetesBaremetalNode", kubernetesBaremetalNodePowerOnPath, id)
 	url := h.client.buildURL(kubernetesBaremetalNodePowerOnPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -738,6 +771,7 @@ func (h *HostsHandler) PowerOnKubernetesBaremetalNode(ctx context.Context, id st
 // Power off a Kubernetes bare metal node
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Kubernetes-Baremetal-Node/operation/PowerOffAKubernetesBareMetalNode
 func (h *HostsHandler) PowerOffKubernetesBaremetalNode(ctx context.Context, id string) (*KubernetesBaremetalNode, error) {
+	ctx = withOperation(ctx, "Hosts.PowerOffKubernetesBaremetalNode", kubernetesBaremetalNodePowerOffPath, id)
 	url := h.client.buildURL(kubernetesBaremetalNodePowerOffPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -757,6 +791,7 @@ func (h *HostsHandler) PowerOffKubernetesBaremetalNode(ctx context.Context, id s
 // Powercycle a Kubernetes bare metal node
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Kubernetes-Baremetal-Node/operation/PowercycleAKubernetesBareMetalNode
 func (h *HostsHandler) PowerCycleKubernetesBaremetalNode(ctx context.Context, id string) (*KubernetesBaremetalNode, error) {
+	ctx = withOperation(ctx, "Hosts.PowerCycleKubernetesBaremetalNode", kubernetesBaremetalNodePowerCyclePath, id)
 	url := h.client.buildURL(kubernetesBaremetalNodePowerCyclePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -776,6 +811,7 @@ func (h *HostsHandler) PowerCycleKubernetesBaremetalNode(ctx context.Context, id
 // Get network utilization for a dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/GetNetworkUsageForADedicatedServer
 func (h *HostsHandler) GetDedicatedServerNetworkUsage(ctx context.Context, id string) (*NetworkUsage, error) {
+	ctx = withOperation(ctx, "Hosts.GetDedicatedServerNetworkUsage", dedicatedServerNetworkUsagePath, id)
 	url := h.client.buildURL(dedicatedServerNetworkUsagePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -794,6 +830,7 @@ func (h *HostsHandler) GetDedicatedServerNetworkUsage(ctx context.Context, id st
 // Get network details for a dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/GetANetworkForADedicatedServer
 func (h *HostsHandler) GetDedicatedServerNetwork(ctx context.Context, serverID, networkID string) (*Network, error) {
+	ctx = withOperation(ctx, "Hosts.GetDedicatedServerNetwork", dedicatedServerNetworkPath, serverID, networkID)
 	url := h.client.buildURL(dedicatedServerNetworkPath, serverID, networkID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -817,6 +854,8 @@ func (h *HostsHandler) AddDedicatedServerPublicIPv4Network(ctx context.Context,
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.AddDedicatedServerPublicIPv4Network", dedicatedServerAddPublicIPv4NetworkPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(dedicatedServerAddPublicIPv4NetworkPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -840,6 +879,8 @@ func (h *HostsHandler) AddDedicatedServerPrivateIPv4Network(ctx context.Context,
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.AddDedicatedServerPrivateIPv4Network", dedicatedServerAddPrivateIPv4NetworkPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(dedicatedServerAddPrivateIPv4NetworkPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -858,6 +899,7 @@ func (h *HostsHandler) AddDedicatedServerPrivateIPv4Network(ctx context.Context,
 // Activate a public IPv6 network for a dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/ActivateAPublicIpv6NetworkForADedicatedServer
 func (h *HostsHandler) ActivateDedicatedServerPubliIPv6Network(ctx context.Context, id string) (*Network, error) {
+	ctx = withOperation(ctx, "Hosts.ActivateDedicatedServerPubliIPv6Network", dedicatedServerActivatePublicIPv6Path, id)
 	url := h.client.buildURL(dedicatedServerActivatePublicIPv6Path, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -876,6 +918,7 @@ func (h *HostsHandler) ActivateDedicatedServerPubliIPv6Network(ctx context.Conte
 // Delete a network from a dedicated server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeleteANetworkForADedicatedServer
 func (h *HostsHandler) DeleteDedicatedServerNetwork(ctx context.Context, serverID, networkID string) (*Network, error) {
+	ctx = withOperation(ctx, "Hosts.DeleteDedicatedServerNetwork", dedicatedServerDeleteNetworkPath, serverID, networkID)
 	url := h.client.buildURL(dedicatedServerDeleteNetworkPath, serverID, networkID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -894,19 +937,19 @@ func (h *HostsHandler) DeleteDedicatedServerNetwork(ctx context.Context, serverI
 // ListDedicatedServers returns a collection of all dedicated servers
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/ListDedicatedServers
 func (h *HostsHandler) ListDedicatedServers() Collection[DedicatedServer] {
-	return NewCollection[DedicatedServer](h.client, dedicatedServersListPath)
+	return NewCollection[DedicatedServer](h.client, dedicatedServersListPath).withOperation("Hosts.ListDedicatedServers", dedicatedServersListPath)
 }
 
 // ListKubernetesBaremetalNodes returns a collection of all Kubernetes bare metal nodes
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Kubernetes-Baremetal-Node/operation/ListKubernetesBaremetalNodes
 func (h *HostsHandler) ListKubernetesBaremetalNodes() Collection[KubernetesBaremetalNode] {
-	return NewCollection[KubernetesBaremetalNode](h.client, kubernetesBaremetalNodesListPath)
+	return NewCollection[KubernetesBaremetalNode](h.client, kubernetesBaremetalNodesListPath).withOperation("Hosts.ListKubernetesBaremetalNodes", kubernetesBaremetalNodesListPath)
 }
 
 // ListSBMServers returns a collection of all scalable bare metal servers
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Scalable-Baremetal-Server/operation/ListSbmServers
 func (h *HostsHandler) ListSBMServers() Collection[SBMServer] {
-	return NewCollection[SBMServer](h.client, sbmServersListPath)
+	return NewCollection[SBMServer](h.client, sbmServersListPath).withOperation("Hosts.ListSBMServers", sbmServersListPath)
 }
 
 // DedicatedServerServices builds a new Collection[DedicatedServerService] interface
@@ -914,7 +957,7 @@ func (h *HostsHandler) ListSBMServers() Collection[SBMServer] {
 func (h *HostsHandler) DedicatedServerServices(id string) Collection[DedicatedServerService] {
 	path := h.client.buildPath(dedicatedServerServicesPath, id)
 
-	return NewCollection[DedicatedServerService](h.client, path)
+	return NewCollection[DedicatedServerService](h.client, path).withOperation("Hosts.DedicatedServerServices", dedicatedServerServicesPath, id)
 }
 
 // DedicatedServerFeatures builds a new Collection[DedicatedServerFeature] interface
@@ -922,11 +965,12 @@ func (h *HostsHandler) DedicatedServerServices(id string) Collection[DedicatedSe
 func (h *HostsHandler) DedicatedServerFeatures(id string) Collection[DedicatedServerFeature] {
 	path := h.client.buildPath(dedicatedServerFeaturesPath, id)
 
-	return NewCollection[DedicatedServerFeature](h.client, path)
+	return NewCollection[DedicatedServerFeature](h.client, path).withOperation("Hosts.DedicatedServerFeatures", dedicatedServerFeaturesPath, id)
 }
 
 // activateFeature is an internal helper that calls POST .../features/{feature}/activate.
-func (h *HostsHandler) activateFeature(ctx context.Context, serverID, feature string, payload []byte) (*DedicatedServerFeature, error) {
+func (h *HostsHandler) activateFeature(ctx context.Context, operationName, serverID, feature string, payload []byte) (*DedicatedServerFeature, error) {
+	ctx = withOperation(ctx, operationName, dedicatedServerFeatureActivatePath, serverID, feature)
 	url := h.client.buildURL(dedicatedServerFeatureActivatePath, serverID, feature)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -943,7 +987,8 @@ func (h *HostsHandler) activateFeature(ctx context.Context, serverID, feature st
 }
 
 // deactivateFeature is an internal helper that calls POST .../features/{feature}/deactivate.
-func (h *HostsHandler) deactivateFeature(ctx context.Context, serverID, feature string) (*DedicatedServerFeature, error) {
+func (h *HostsHandler) deactivateFeature(ctx context.Context, operationName, serverID, feature string) (*DedicatedServerFeature, error) {
+	ctx = withOperation(ctx, operationName, dedicatedServerFeatureDeactivatePath, serverID, feature)
 	url := h.client.buildURL(dedicatedServerFeatureDeactivatePath, serverID, feature)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
@@ -962,73 +1007,73 @@ func (h *HostsHandler) deactivateFeature(ctx context.Context, serverID, feature
 // ActivateDisaggregatedPublicPortsFeature activates the disaggregated_public_ports feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/ActivateDisaggregatedPublicPortsFeatureForADedicatedServer
 func (h *HostsHandler) ActivateDisaggregatedPublicPortsFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.activateFeature(ctx, serverID, "disaggregated_public_ports", nil)
+	return h.activateFeature(ctx, "Hosts.ActivateDisaggregatedPublicPortsFeature", serverID, "disaggregated_public_ports", nil)
 }
 
 // DeactivateDisaggregatedPublicPortsFeature deactivates the disaggregated_public_ports feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeactivateDisaggregatedPublicPortsFeatureForADedicatedServer
 func (h *HostsHandler) DeactivateDisaggregatedPublicPortsFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.deactivateFeature(ctx, serverID, "disaggregated_public_ports")
+	return h.deactivateFeature(ctx, "Hosts.DeactivateDisaggregatedPublicPortsFeature", serverID, "disaggregated_public_ports")
 }
 
 // ActivateDisaggregatedPrivatePortsFeature activates the disaggregated_private_ports feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeactivateDisaggregatedPrivatePortsFeatureForADedicatedServer
 func (h *HostsHandler) ActivateDisaggregatedPrivatePortsFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.activateFeature(ctx, serverID, "disaggregated_private_ports", nil)
+	return h.activateFeature(ctx, "Hosts.ActivateDisaggregatedPrivatePortsFeature", serverID, "disaggregated_private_ports", nil)
 }
 
 // DeactivateDisaggregatedPrivatePortsFeature deactivates the disaggregated_private_ports feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeactivateDisaggregatedPrivatePortsFeatureForADedicatedServer
 func (h *HostsHandler) DeactivateDisaggregatedPrivatePortsFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.deactivateFeature(ctx, serverID, "disaggregated_private_ports")
+	return h.deactivateFeature(ctx, "Hosts.DeactivateDisaggregatedPrivatePortsFeature", serverID, "disaggregated_private_ports")
 }
 
 // ActivateNoPublicIpAddressFeature activates the no_public_ip_address feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/ActivateNoPublicIpAddressFeatureForADedicatedServer
 func (h *HostsHandler) ActivateNoPublicIpAddressFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.activateFeature(ctx, serverID, "no_public_ip_address", nil)
+	return h.activateFeature(ctx, "Hosts.ActivateNoPublicIpAddressFeature", serverID, "no_public_ip_address", nil)
 }
 
 // DeactivateNoPublicIpAddressFeature deactivates the no_public_ip_address feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeactivateNoPublicIpAddressFeatureForADedicatedServer
 func (h *HostsHandler) DeactivateNoPublicIpAddressFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.deactivateFeature(ctx, serverID, "no_public_ip_address")
+	return h.deactivateFeature(ctx, "Hosts.DeactivateNoPublicIpAddressFeature", serverID, "no_public_ip_address")
 }
 
 // ActivateNoPrivateIpFeature activates the no_private_ip feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/ActivateNoPrivateIpFeatureForADedicatedServer
 func (h *HostsHandler) ActivateNoPrivateIpFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.activateFeature(ctx, serverID, "no_private_ip", nil)
+	return h.activateFeature(ctx, "Hosts.ActivateNoPrivateIpFeature", serverID, "no_private_ip", nil)
 }
 
 // DeactivateNoPrivateIpFeature deactivates the no_private_ip feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeactivateNoPrivateIpFeatureForADedicatedServer
 func (h *HostsHandler) DeactivateNoPrivateIpFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.deactivateFeature(ctx, serverID, "no_private_ip")
+	return h.deactivateFeature(ctx, "Hosts.DeactivateNoPrivateIpFeature", serverID, "no_private_ip")
 }
 
 // ActivateOobPublicAccessFeature activates the oob_public_access feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/ActivateOobPublicAccessFeatureForADedicatedServer
 func (h *HostsHandler) ActivateOobPublicAccessFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.activateFeature(ctx, serverID, "oob_public_access", nil)
+	return h.activateFeature(ctx, "Hosts.ActivateOobPublicAccessFeature", serverID, "oob_public_access", nil)
 }
 
 // DeactivateOobPublicAccessFeature deactivates the oob_public_access feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeactivateOobPublicAccessFeatureForADedicatedServer
 func (h *HostsHandler) DeactivateOobPublicAccessFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.deactivateFeature(ctx, serverID, "oob_public_access")
+	return h.deactivateFeature(ctx, "Hosts.DeactivateOobPublicAccessFeature", serverID, "oob_public_access")
 }
 
 // ActivateNoPublicNetworkFeature activates the no_public_network feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/ActivateNoPublicNetworkFeatureForADedicatedServer
 func (h *HostsHandler) ActivateNoPublicNetworkFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.activateFeature(ctx, serverID, "no_public_network", nil)
+	return h.activateFeature(ctx, "Hosts.ActivateNoPublicNetworkFeature", serverID, "no_public_network", nil)
 }
 
 // DeactivateNoPublicNetworkFeature deactivates the no_public_network feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeactivateNoPublicNetworkFeatureForADedicatedServer
 func (h *HostsHandler) DeactivateNoPublicNetworkFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.deactivateFeature(ctx, serverID, "no_public_network")
+	return h.deactivateFeature(ctx, "Hosts.DeactivateNoPublicNetworkFeature", serverID, "no_public_network")
 }
 
 // ActivateHostRescueModeFeature activates the host_rescue_mode feature.
@@ -1039,13 +1084,13 @@ func (h *HostsHandler) ActivateHostRescueModeFeature(ctx context.Context, server
 		return nil, err
 	}
 
-	return h.activateFeature(ctx, serverID, "host_rescue_mode", payload)
+	return h.activateFeature(withInput(ctx, input), "Hosts.ActivateHostRescueModeFeature", serverID, "host_rescue_mode", payload)
 }
 
 // DeactivateHostRescueModeFeature deactivates the host_rescue_mode feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeactivateHostRescueModeFeatureForADedicatedServer
 func (h *HostsHandler) DeactivateHostRescueModeFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.deactivateFeature(ctx, serverID, "host_rescue_mode")
+	return h.deactivateFeature(ctx, "Hosts.DeactivateHostRescueModeFeature", serverID, "host_rescue_mode")
 }
 
 // ActivatePrivateIpxeBootFeature activates the private_ipxe_boot feature.
@@ -1056,18 +1101,19 @@ func (h *HostsHandler) ActivatePrivateIpxeBootFeature(ctx context.Context, serve
 		return nil, err
 	}
 
-	return h.activateFeature(ctx, serverID, "private_ipxe_boot", payload)
+	return h.activateFeature(withInput(ctx, input), "Hosts.ActivatePrivateIpxeBootFeature", serverID, "private_ipxe_boot", payload)
 }
 
 // DeactivatePrivateIpxeBootFeature deactivates the private_ipxe_boot feature.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DeactivatePrivateIpxeBootFeatureForADedicatedServer
 func (h *HostsHandler) DeactivatePrivateIpxeBootFeature(ctx context.Context, serverID string) (*DedicatedServerFeature, error) {
-	return h.deactivateFeature(ctx, serverID, "private_ipxe_boot")
+	return h.deactivateFeature(ctx, "Hosts.DeactivatePrivateIpxeBootFeature", serverID, "private_ipxe_boot")
 }
 
 // ListDedicatedServerSSHKeys returns all SSH keys attached to a dedicated server.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/ListSshKeysForADedicatedServer
 func (h *HostsHandler) ListDedicatedServerSSHKeys(ctx context.Context, id string) ([]SSHKey, error) {
+	ctx = withOperation(ctx, "Hosts.ListDedicatedServerSSHKeys", dedicatedServerSSHKeysPath, id)
 	url := h.client.buildURL(dedicatedServerSSHKeysPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -1091,6 +1137,8 @@ func (h *HostsHandler) AttachSSHKeysToDedicatedServer(ctx context.Context, id st
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.AttachSSHKeysToDedicatedServer", dedicatedServerSSHKeysPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(dedicatedServerSSHKeysPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -1109,6 +1157,7 @@ func (h *HostsHandler) AttachSSHKeysToDedicatedServer(ctx context.Context, id st
 // DetachSSHKeyFromDedicatedServer removes a single SSH key from a dedicated server.
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/DetachAnSshKeyFromADedicatedServer
 func (h *HostsHandler) DetachSSHKeyFromDedicatedServer(ctx context.Context, serverID, fingerprint string) error {
+	ctx = withOperation(ctx, "Hosts.DetachSSHKeyFromDedicatedServer", dedicatedServerSSHKeyPath, serverID, fingerprint)
 	url := h.client.buildURL(dedicatedServerSSHKeyPath, serverID, fingerprint)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -1119,6 +1168,7 @@ func (h *HostsHandler) DetachSSHKeyFromDedicatedServer(ctx context.Context, serv
 // GetDedicatedServerOOBCredentials returns dedicated server OOB credentials
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Dedicated-Server/operation/GetOobCredentialsForADedicatedServer
 func (h *HostsHandler) GetDedicatedServerOOBCredentials(ctx context.Context, id string, params map[string]string) (*DedicatedServerOOBCredentials, error) {
+	ctx = withOperation(ctx, "Hosts.GetDedicatedServerOOBCredentials", dedicatedServerOOBCredentialsPath, id)
 	url := h.client.buildURL(dedicatedServerOOBCredentialsPath, id)
 	urlWithParams := h.client.applyParams(url, params)
 	body, err := h.client.buildAndExecRequest(ctx, "GET", urlWithParams, nil)
@@ -1135,6 +1185,7 @@ func (h *HostsHandler) GetDedicatedServerOOBCredentials(ctx context.Context, id
 // SBMServerPowerFeeds returns list of sbm server power feeds with status
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Scalable-Baremetal-Server/operation/ListPowerFeedsForAnSbmServer
 func (h *HostsHandler) SBMServerPowerFeeds(ctx context.Context, id string) ([]HostPowerFeed, error) {
+	ctx = withOperation(ctx, "Hosts.SBMServerPowerFeeds", hostPowerFeedsListPath, sbmPrefix, id)
 	url := h.client.buildURL(hostPowerFeedsListPath, []interface{}{sbmPrefix, id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -1157,7 +1208,7 @@ func (h *HostsHandler) SBMServerPowerFeeds(ctx context.Context, id string) ([]Ho
 func (h *HostsHandler) SBMServerPTRRecords(id string) Collection[PTRRecord] {
 	path := h.client.buildPath(hostPTRsListPath, []interface{}{sbmPrefix, id}...)
 
-	return NewCollection[PTRRecord](h.client, path)
+	return NewCollection[PTRRecord](h.client, path).withOperation("Hosts.SBMServerPTRRecords", hostPTRsListPath, sbmPrefix, id)
 }
 
 // CreatePTRRecordForSBMServer creates ptr record for the sbm server
@@ -1169,6 +1220,8 @@ func (h *HostsHandler) CreatePTRRecordForSBMServer(ctx context.Context, id strin
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Hosts.CreatePTRRecordForSBMServer", sbmServerPTRRecordCreatePath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(sbmServerPTRRecordCreatePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -1189,6 +1242,7 @@ func (h *HostsHandler) CreatePTRRecordForSBMServer(ctx context.Context, id strin
 // DeletePTRRecordForSBMServer deleted ptr record for the sbm server
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Scalable-Baremetal-Server/operation/DeleteAPtrRecordForAnSbmServer
 func (h *HostsHandler) DeletePTRRecordForSBMServer(ctx context.Context, hostID string, ptrRecordID string) error {
+	ctx = withOperation(ctx, "Hosts.DeletePTRRecordForSBMServer", sbmServerPTRRecordDeletePath, hostID, ptrRecordID)
 	url := h.client.buildURL(sbmServerPTRRecordDeletePath, []interface{}{hostID, ptrRecordID}...)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -0,0 +1,946 @@
+package serverscom
+
+var (
+	raidLevels            = map[int]bool{0: true, 1: true, 5: true, 6: true, 10: true, 50: true, 60: true}
+	distributionMethods   = []string{"route", "gateway"}
+	l2SegmentTypes        = []string{"public", "private"}
+	l2SegmentMemberModes  = []string{"native", "trunk"}
+	rescueModeAuthMethods = []string{"password", "ssh_key"}
+	realIPHeaderNames     = []string{string(RealIP), string(ForwardedFor)}
+)
+
+// Validate checks the SSL certificate input
+func (i SSLCertificateCreateCustomInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.pemEncoded(i.PublicKey, "public_key")
+	v.pemEncoded(i.PrivateKey, "private_key")
+
+	if i.ChainKey != "" {
+		v.pemEncoded(i.ChainKey, "chain_key")
+	}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the SSL certificate input
+func (i SSLCertificateUpdateCustomInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.pemEncoded(i.PublicKey, "public_key")
+	v.pemEncoded(i.PrivateKey, "private_key")
+
+	if i.ChainKey != "" {
+		v.pemEncoded(i.ChainKey, "chain_key")
+	}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the SSL certificate input
+func (i SSLCertificateUpdateLEInput) Validate() error {
+	v := &inputValidator{}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the partition input
+func (i DedicatedServerLayoutPartitionInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Target, "target")
+
+	if i.Fill {
+		v.notNegative(int64(i.Size), "size")
+	} else {
+		v.positive(int64(i.Size), "size")
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the layout input
+func (i DedicatedServerLayoutInput) Validate() error {
+	v := &inputValidator{}
+
+	v.notEmpty(len(i.SlotPositions), "slot_positions")
+
+	if i.Raid != nil {
+		v.check(raidLevels[*i.Raid], "raid", "is not a valid RAID level")
+	}
+
+	for n, partition := range i.Partitions {
+		v.nested(indexPath("partitions", n), partition.Validate())
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the slot input
+func (i DedicatedServerSlotInput) Validate() error {
+	v := &inputValidator{}
+
+	v.notNegative(int64(i.Position), "position")
+
+	if i.DriveModelID != nil {
+		v.positive(*i.DriveModelID, "drive_model_id")
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the drives input, layout slot positions must refer to slots if slots are set
+func (i DedicatedServerDrivesInput) Validate() error {
+	v := &inputValidator{}
+
+	positions := make(map[int]bool, len(i.Slots))
+
+	for n, slot := range i.Slots {
+		v.nested(indexPath("slots", n), slot.Validate())
+		positions[slot.Position] = true
+	}
+
+	for n, layout := range i.Layout {
+		path := indexPath("layout", n)
+
+		v.nested(path, layout.Validate())
+
+		if len(i.Slots) == 0 {
+			continue
+		}
+
+		for m, position := range layout.SlotPositions {
+			v.check(positions[position], indexPath(path+".slot_positions", m), "must refer to a slot position")
+		}
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the public uplink input
+func (i DedicatedServerPublicUplinkInput) Validate() error {
+	v := &inputValidator{}
+
+	v.positive(i.ID, "id")
+	v.positive(i.BandwidthModelID, "bandwidth_model_id")
+
+	return v.result(i)
+}
+
+// Validate checks the private uplink input
+func (i DedicatedServerPrivateUplinkInput) Validate() error {
+	v := &inputValidator{}
+
+	v.positive(i.ID, "id")
+
+	return v.result(i)
+}
+
+// Validate checks the uplink models input
+func (i DedicatedServerUplinkModelsInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.Public != nil {
+		v.nested("public", i.Public.Validate())
+	}
+
+	v.nested("private", i.Private.Validate())
+
+	return v.result(i)
+}
+
+// Validate checks the host input
+func (i DedicatedServerHostInput) Validate() error {
+	v := &inputValidator{}
+
+	v.hostname(i.Hostname, "hostname")
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the dedicated server input
+func (i DedicatedServerCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.positive(i.ServerModelID, "server_model_id")
+	v.positive(i.LocationID, "location_id")
+	v.positive(int64(i.RAMSize), "ram_size")
+	v.nested("uplink_models", i.UplinkModels.Validate())
+	v.nested("drives", i.Drives.Validate())
+	v.notEmpty(len(i.Hosts), "hosts")
+
+	for n, host := range i.Hosts {
+		v.nested(indexPath("hosts", n), host.Validate())
+	}
+
+	if i.OperatingSystemID != nil {
+		v.positive(*i.OperatingSystemID, "operating_system_id")
+	}
+
+	for n, fingerprint := range i.SSHKeyFingerprints {
+		v.required(fingerprint, indexPath("ssh_key_fingerprints", n))
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the host input
+func (i SBMServerHostInput) Validate() error {
+	v := &inputValidator{}
+
+	v.hostname(i.Hostname, "hostname")
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the SBM server input
+func (i SBMServerCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.positive(i.FlavorModelID, "sbm_flavor_model_id")
+	v.positive(i.LocationID, "location_id")
+	v.notEmpty(len(i.Hosts), "hosts")
+
+	for n, host := range i.Hosts {
+		v.nested(indexPath("hosts", n), host.Validate())
+	}
+
+	if i.OperatingSystemID != nil {
+		v.positive(*i.OperatingSystemID, "operating_system_id")
+	}
+
+	for n, fingerprint := range i.SSHKeyFingerprints {
+		v.required(fingerprint, indexPath("ssh_key_fingerprints", n))
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the SSH key input
+func (i SSHKeyCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.required(i.PublicKey, "public_key")
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the SSH key input
+func (i SSHKeyUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the SSH keys input
+func (i SSHKeyAttachInput) Validate() error {
+	v := &inputValidator{}
+
+	v.notEmpty(len(i.SSHKeyFingerprints), "ssh_key_fingerprints")
+
+	for n, fingerprint := range i.SSHKeyFingerprints {
+		v.required(fingerprint, indexPath("ssh_key_fingerprints", n))
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the host_rescue_mode feature input
+func (i HostRescueModeFeatureInput) Validate() error {
+	v := &inputValidator{}
+
+	v.notEmpty(len(i.AuthMethods), "auth_methods")
+
+	for n, method := range i.AuthMethods {
+		v.oneOf(method, indexPath("auth_methods", n), rescueModeAuthMethods...)
+	}
+
+	for n, fingerprint := range i.SSHKeyFingerprints {
+		v.required(fingerprint, indexPath("ssh_key_fingerprints", n))
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the private_ipxe_boot feature input
+func (i PrivateIpxeBootFeatureInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.IPXEConfig, "ipxe_config")
+
+	return v.result(i)
+}
+
+// Validate checks the cloud instance input
+func (i CloudComputingInstanceCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.positive(i.RegionID, "region_id")
+	v.required(i.FlavorID, "flavor_id")
+	v.required(i.ImageID, "image_id")
+
+	if i.BackupCopies != nil {
+		v.notNegative(int64(*i.BackupCopies), "backup_copies")
+	}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the cloud instance input
+func (i CloudComputingInstanceUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.Name != nil {
+		v.required(*i.Name, "name")
+	}
+
+	if i.BackupCopies != nil {
+		v.notNegative(int64(*i.BackupCopies), "backup_copies")
+	}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the cloud instance input
+func (i CloudComputingInstanceReinstallInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.ImageID, "image_id")
+
+	return v.result(i)
+}
+
+// Validate checks the cloud instance input
+func (i CloudComputingInstanceUpgradeInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.FlavorID, "flavor_id")
+
+	return v.result(i)
+}
+
+// Validate checks the snapshot input
+func (i CloudSnapshotCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.required(i.InstanceID, "instance_id")
+
+	return v.result(i)
+}
+
+// Validate checks the member input, mode must be native or trunk
+func (i L2SegmentMemberInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.ID, "id")
+	v.oneOf(i.Mode, "mode", l2SegmentMemberModes...)
+
+	return v.result(i)
+}
+
+// Validate checks the L2 segment input
+func (i L2SegmentCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.Name != nil {
+		v.required(*i.Name, "name")
+	}
+
+	v.oneOf(i.Type, "type", l2SegmentTypes...)
+	v.positive(i.LocationGroupID, "location_group_id")
+	v.notEmpty(len(i.Members), "members")
+
+	for n, member := range i.Members {
+		v.nested(indexPath("members", n), member.Validate())
+	}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the L2 segment input
+func (i L2SegmentUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.Name != nil {
+		v.required(*i.Name, "name")
+	}
+
+	for n, member := range i.Members {
+		v.nested(indexPath("members", n), member.Validate())
+	}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the network input
+func (i L2SegmentCreateNetworksInput) Validate() error {
+	v := &inputValidator{}
+
+	v.mask(i.Mask, "mask")
+	v.oneOf(i.DistributionMethod, "distribution_method", distributionMethods...)
+
+	return v.result(i)
+}
+
+// Validate checks the networks input
+func (i L2SegmentChangeNetworksInput) Validate() error {
+	v := &inputValidator{}
+
+	for n, network := range i.Create {
+		v.nested(indexPath("create", n), network.Validate())
+	}
+
+	for n, id := range i.Delete {
+		v.required(id, indexPath("delete", n))
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the PTR record input
+func (i PTRRecordCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.ip(i.IP, "ip")
+	v.hostname(i.Domain, "domain")
+
+	if i.Priority != nil {
+		v.notNegative(int64(*i.Priority), "priority")
+	}
+
+	if i.TTL != nil {
+		v.positive(int64(*i.TTL), "ttl")
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the partition input
+func (i OperatingSystemReinstallPartitionInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Target, "target")
+
+	if i.Fill {
+		v.notNegative(int64(i.Size), "size")
+	} else {
+		v.positive(int64(i.Size), "size")
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the layout input
+func (i OperatingSystemReinstallLayoutInput) Validate() error {
+	v := &inputValidator{}
+
+	v.notEmpty(len(i.SlotPositions), "slot_positions")
+
+	if i.Raid != nil {
+		v.check(raidLevels[*i.Raid], "raid", "is not a valid RAID level")
+	}
+
+	for n, partition := range i.Partitions {
+		v.nested(indexPath("partitions", n), partition.Validate())
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the drives input
+func (i OperatingSystemReinstallDrivesInput) Validate() error {
+	v := &inputValidator{}
+
+	for n, layout := range i.Layout {
+		v.nested(indexPath("layout", n), layout.Validate())
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the os reinstallation input
+func (i OperatingSystemReinstallInput) Validate() error {
+	v := &inputValidator{}
+
+	v.hostname(i.Hostname, "hostname")
+	v.nested("drives", i.Drives.Validate())
+
+	if i.OperatingSystemID != nil {
+		v.positive(*i.OperatingSystemID, "operating_system_id")
+	}
+
+	for n, fingerprint := range i.SSHKeyFingerprints {
+		v.required(fingerprint, indexPath("ssh_key_fingerprints", n))
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the os reinstallation input
+func (i SBMOperatingSystemReinstallInput) Validate() error {
+	v := &inputValidator{}
+
+	v.hostname(i.Hostname, "hostname")
+	v.positive(i.OperatingSystemID, "operating_system_id")
+
+	for n, fingerprint := range i.SSHKeyFingerprints {
+		v.required(fingerprint, indexPath("ssh_key_fingerprints", n))
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the network pool input
+func (i NetworkPoolInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.Title != nil {
+		v.required(*i.Title, "title")
+	}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the subnetwork input
+func (i SubnetworkUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.Title != nil {
+		v.required(*i.Title, "title")
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the subnetwork input, either cidr or mask must be set
+func (i SubnetworkCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.Title != nil {
+		v.required(*i.Title, "title")
+	}
+
+	if i.CIDR != nil {
+		v.cidr(*i.CIDR, "cidr")
+	}
+
+	if i.Mask != nil {
+		v.mask(*i.Mask, "mask")
+	}
+
+	v.check(i.CIDR != nil || i.Mask != nil, "cidr", "can't be blank if mask isn't set")
+
+	return v.result(i)
+}
+
+// Validate checks the vhost zone input
+func (i L4VHostZoneInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.ID, "id")
+	v.notEmpty(len(i.Ports), "ports")
+
+	for n, port := range i.Ports {
+		v.port(port, indexPath("ports", n))
+	}
+
+	v.required(i.UpstreamID, "upstream_id")
+
+	return v.result(i)
+}
+
+// Validate checks the upstream input
+func (i L4UpstreamInput) Validate() error {
+	v := &inputValidator{}
+
+	v.ip(i.IP, "ip")
+	v.port(i.Port, "port")
+	v.notNegative(int64(i.Weight), "weight")
+
+	return v.result(i)
+}
+
+// Validate checks the upstream zone input
+func (i L4UpstreamZoneInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.ID, "id")
+
+	v.notEmpty(len(i.Upstreams), "upstreams")
+
+	for n, upstream := range i.Upstreams {
+		v.nested(indexPath("upstreams", n), upstream.Validate())
+	}
+
+	return v.result(i)
+}
+
+// validateL4Zones checks zones and that vhost zones refer to upstream zones
+func validateL4Zones(v *inputValidator, vhostZones []L4VHostZoneInput, upstreamZones []L4UpstreamZoneInput) {
+	upstreamIDs := make(map[string]bool, len(upstreamZones))
+
+	for n, zone := range upstreamZones {
+		v.nested(indexPath("upstream_zones", n), zone.Validate())
+		upstreamIDs[zone.ID] = true
+	}
+
+	for n, zone := range vhostZones {
+		path := indexPath("vhost_zones", n)
+
+		v.nested(path, zone.Validate())
+
+		if len(upstreamZones) > 0 && zone.UpstreamID != "" {
+			v.check(upstreamIDs[zone.UpstreamID], path+".upstream_id", "must match an upstream zone id")
+		}
+	}
+}
+
+// Validate checks the L4 load balancer input
+func (i L4LoadBalancerUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.Name != nil {
+		v.required(*i.Name, "name")
+	}
+
+	validateL4Zones(v, i.VHostZones, i.UpstreamZones)
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the L4 load balancer input
+func (i L4LoadBalancerCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.positive(i.LocationID, "location_id")
+	v.notEmpty(len(i.VHostZones), "vhost_zones")
+	v.notEmpty(len(i.UpstreamZones), "upstream_zones")
+	validateL4Zones(v, i.VHostZones, i.UpstreamZones)
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the location zone input
+func (i L7LocationZoneInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Location, "location")
+	v.required(i.UpstreamID, "upstream_id")
+
+	return v.result(i)
+}
+
+// Validate checks the vhost zone input
+func (i L7VHostZoneInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.ID, "id")
+	v.notEmpty(len(i.Ports), "ports")
+
+	for n, port := range i.Ports {
+		v.port(port, indexPath("ports", n))
+	}
+
+	v.notEmpty(len(i.Domains), "domains")
+
+	for n, domain := range i.Domains {
+		v.hostname(domain, indexPath("domains", n))
+	}
+
+	if i.SSL {
+		v.required(i.SSLCertID, "ssl_certificate_id")
+	}
+
+	v.notEmpty(len(i.LocationZones), "location_zones")
+
+	for n, zone := range i.LocationZones {
+		v.nested(indexPath("location_zones", n), zone.Validate())
+	}
+
+	if i.RealIPHeader != nil {
+		v.oneOf(string(i.RealIPHeader.Name), "real_ip_header.name", realIPHeaderNames...)
+
+		for n, network := range i.RealIPHeader.Networks {
+			v.cidr(network, indexPath("real_ip_header.networks", n))
+		}
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the upstream input
+func (i L7UpstreamInput) Validate() error {
+	v := &inputValidator{}
+
+	v.ip(i.IP, "ip")
+	v.port(i.Port, "port")
+	v.notNegative(int64(i.Weight), "weight")
+	v.notNegative(int64(i.MaxConns), "max_conns")
+	v.notNegative(int64(i.MaxFails), "max_fails")
+	v.notNegative(int64(i.FailTimeout), "fail_timeout")
+
+	return v.result(i)
+}
+
+// Validate checks the upstream zone input
+func (i L7UpstreamZoneInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.ID, "id")
+
+	v.notEmpty(len(i.Upstreams), "upstreams")
+
+	for n, upstream := range i.Upstreams {
+		v.nested(indexPath("upstreams", n), upstream.Validate())
+	}
+
+	return v.result(i)
+}
+
+// validateL7Zones checks zones and that location zones of vhost zones refer to upstream zones
+func validateL7Zones(v *inputValidator, vhostZones []L7VHostZoneInput, upstreamZones []L7UpstreamZoneInput) {
+	upstreamIDs := make(map[string]bool, len(upstreamZones))
+
+	for n, zone := range upstreamZones {
+		v.nested(indexPath("upstream_zones", n), zone.Validate())
+		upstreamIDs[zone.ID] = true
+	}
+
+	for n, zone := range vhostZones {
+		path := indexPath("vhost_zones", n)
+
+		v.nested(path, zone.Validate())
+
+		if len(upstreamZones) == 0 {
+			continue
+		}
+
+		for m, location := range zone.LocationZones {
+			if location.UpstreamID != "" {
+				v.check(upstreamIDs[location.UpstreamID], indexPath(path+".location_zones", m)+".upstream_id", "must match an upstream zone id")
+			}
+		}
+	}
+}
+
+// Validate checks the L7 load balancer input
+func (i L7LoadBalancerUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.NewExternalIpsCount != nil {
+		v.notNegative(int64(*i.NewExternalIpsCount), "new_external_ips_count")
+	}
+
+	for n, ip := range i.DeleteExternalIps {
+		v.ip(ip, indexPath("delete_external_ips", n))
+	}
+
+	validateL7Zones(v, i.VHostZones, i.UpstreamZones)
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the L7 load balancer input
+func (i L7LoadBalancerCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.positive(i.LocationID, "location_id")
+	v.notEmpty(len(i.VHostZones), "vhost_zones")
+	v.notEmpty(len(i.UpstreamZones), "upstream_zones")
+	validateL7Zones(v, i.VHostZones, i.UpstreamZones)
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the dedicated server input
+func (i DedicatedServerUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the Kubernetes node input
+func (i KubernetesBaremetalNodeUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the SBM server input
+func (i SBMServerUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the rack input
+func (i RackUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the volume input
+func (i CloudBlockStorageVolumeCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.positive(int64(i.RegionID), "region_id")
+	v.notNegative(int64(i.Size), "size")
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the volume input
+func (i CloudBlockStorageVolumeUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the volume input
+func (i CloudBlockStorageVolumeAttachInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.InstanceID, "instance_id")
+
+	return v.result(i)
+}
+
+// Validate checks the volume input
+func (i CloudBlockStorageVolumeDetachInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.InstanceID, "instance_id")
+
+	return v.result(i)
+}
+
+// Validate checks the backup input
+func (i CloudBlockStorageBackupCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.VolumeID, "volume_id")
+	v.required(i.Name, "name")
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the backup input
+func (i CloudBlockStorageBackupUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the backup input
+func (i CloudBlockStorageBackupRestoreInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.VolumeID, "volume_id")
+
+	return v.result(i)
+}
+
+// Validate checks the Kubernetes cluster input
+func (i KubernetesClusterUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the network input, mask must be between 1 and 32
+func (i NetworkInput) Validate() error {
+	v := &inputValidator{}
+
+	v.mask(i.Mask, "mask")
+
+	if i.DistributionMethod != "" {
+		v.oneOf(i.DistributionMethod, "distribution_method", distributionMethods...)
+	}
+
+	return v.result(i)
+}
+
+// Validate checks the volume input
+func (i RemoteBlockStorageVolumeCreateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.required(i.Name, "name")
+	v.positive(i.Size, "size")
+	v.positive(int64(i.LocationID), "location_id")
+	v.positive(int64(i.FlavorID), "flavor_id")
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the volume input
+func (i RemoteBlockStorageVolumeUpdateInput) Validate() error {
+	v := &inputValidator{}
+
+	v.notNegative(i.Size, "size")
+	v.labels(i.Labels, "labels")
+
+	return v.result(i)
+}
+
+// Validate checks the release input, release_after must be a RFC 3339 time if set
+func (i ScheduleReleaseInput) Validate() error {
+	v := &inputValidator{}
+
+	if i.ReleaseAfter != "" {
+		v.rfc3339(i.ReleaseAfter, "release_after")
+	}
+
+	return v.result(i)
+}
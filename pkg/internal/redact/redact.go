@@ -0,0 +1,116 @@
+// Package redact replaces values of sensitive headers and JSON fields, it's shared by the client
+// logging and the cassette recorder, so both hide the same secrets.
+package redact
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Placeholder replaces redacted values
+const Placeholder = "[REDACTED]"
+
+// defaultHeaders are headers which values are always redacted
+var defaultHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// defaultFields are JSON fields which values are always redacted, e.g. DedicatedServerOOBCredentials.Secret,
+// RemoteBlockStorageVolumeCredentials.Password and SSLCertificateCreateCustomInput.PrivateKey
+var defaultFields = []string{"secret", "password", "private_key", "token"}
+
+// Redactor replaces values of headers and JSON fields from its sets, it's safe for concurrent use
+// once configured
+type Redactor struct {
+	headers map[string]bool
+	fields  map[string]bool
+}
+
+// New builds a new redactor of Authorization, Cookie and Set-Cookie headers and of secret, password,
+// private_key and token fields
+func New() *Redactor {
+	r := &Redactor{
+		headers: make(map[string]bool),
+		fields:  make(map[string]bool),
+	}
+
+	r.AddHeaders(defaultHeaders...)
+	r.AddFields(defaultFields...)
+
+	return r
+}
+
+// AddHeaders adds headers which values are redacted, names are case-insensitive
+func (r *Redactor) AddHeaders(headers ...string) {
+	for _, header := range headers {
+		r.headers[http.CanonicalHeaderKey(header)] = true
+	}
+}
+
+// AddFields adds JSON fields which values are redacted at any depth, names are case-insensitive
+func (r *Redactor) AddFields(fields ...string) {
+	for _, field := range fields {
+		r.fields[strings.ToLower(field)] = true
+	}
+}
+
+// Header returns a copy of the header with values of sensitive headers replaced
+func (r *Redactor) Header(header http.Header) http.Header {
+	result := make(http.Header, len(header))
+
+	for key, values := range header {
+		if r.headers[http.CanonicalHeaderKey(key)] {
+			result[key] = []string{Placeholder}
+			continue
+		}
+
+		result[key] = append([]string(nil), values...)
+	}
+
+	return result
+}
+
+// Body returns the body with values of sensitive JSON fields replaced, bodies which aren't valid JSON
+// or have no sensitive fields are returned as is
+func (r *Redactor) Body(body []byte) []byte {
+	var value interface{}
+
+	if len(body) == 0 || json.Unmarshal(body, &value) != nil {
+		return body
+	}
+
+	if !r.value(value) {
+		return body
+	}
+
+	contents, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+
+	return contents
+}
+
+// value replaces sensitive fields in place, it reports whether anything was replaced
+func (r *Redactor) value(value interface{}) bool {
+	var redacted bool
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if r.fields[strings.ToLower(key)] {
+				v[key] = Placeholder
+				redacted = true
+
+				continue
+			}
+
+			redacted = r.value(nested) || redacted
+		}
+	case []interface{}:
+		for _, nested := range v {
+			redacted = r.value(nested) || redacted
+		}
+	}
+
+	return redacted
+}
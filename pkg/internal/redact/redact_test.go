@@ -0,0 +1,44 @@
+package redact
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRedactorHeader(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	r := New()
+	r.AddHeaders("x-api-key")
+
+	header := http.Header{
+		"Authorization": {"Bearer token"},
+		"Set-Cookie":    {"session=1"},
+		"X-Api-Key":     {"key"},
+		"Accept":        {"application/json"},
+	}
+
+	g.Expect(r.Header(header)).To(Equal(http.Header{
+		"Authorization": {Placeholder},
+		"Set-Cookie":    {Placeholder},
+		"X-Api-Key":     {Placeholder},
+		"Accept":        {"application/json"},
+	}))
+	g.Expect(header.Get("Authorization")).To(Equal("Bearer token"))
+}
+
+func TestRedactorBody(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	r := New()
+	r.AddFields("Login")
+
+	g.Expect(string(r.Body([]byte(`{"login": "admin", "nested": [{"Password": "p"}], "name": "a"}`)))).
+		To(Equal(`{"login":"[REDACTED]","name":"a","nested":[{"Password":"[REDACTED]"}]}`))
+
+	g.Expect(string(r.Body([]byte(`{"name": "a"}`)))).To(Equal(`{"name": "a"}`))
+	g.Expect(string(r.Body([]byte(`secret=1`)))).To(Equal(`secret=1`))
+	g.Expect(r.Body(nil)).To(BeNil())
+}
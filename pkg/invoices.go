@@ -29,12 +29,13 @@ type InvoiceHandler struct {
 
 // Collection builds a new Collection[InvoiceList] interface
 func (h *InvoiceHandler) Collection() Collection[InvoiceList] {
-	return NewCollection[InvoiceList](h.client, invoicesListPath)
+	return NewCollection[InvoiceList](h.client, invoicesListPath).withOperation("Invoices.Collection", invoicesListPath)
 }
 
 // GetBillingInvoice returns an invoice
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Invoice/operation/GetAnInvoice
 func (h *InvoiceHandler) GetBillingInvoice(ctx context.Context, id string) (*Invoice, error) {
+	ctx = withOperation(ctx, "Invoices.GetBillingInvoice", InvoicePath, id)
 	url := h.client.buildURL(InvoicePath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
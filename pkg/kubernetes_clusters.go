@@ -38,7 +38,7 @@ type KubernetesClustersHandler struct {
 // Collection builds a new Collection[KubernetesCluster] interface
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Kubernetes-Cluster/operation/ListKubernetesClusters
 func (h *KubernetesClustersHandler) Collection() Collection[KubernetesCluster] {
-	return NewCollection[KubernetesCluster](h.client, kubernetesClusterPath)
+	return NewCollection[KubernetesCluster](h.client, kubernetesClusterPath).withOperation("KubernetesClusters.Collection", kubernetesClusterPath)
 }
 
 // Nodes builds a new Collection[KubernetesClusterNode] interface
@@ -46,12 +46,13 @@ func (h *KubernetesClustersHandler) Collection() Collection[KubernetesCluster] {
 func (h *KubernetesClustersHandler) Nodes(id string) Collection[KubernetesClusterNode] {
 	path := h.client.buildPath(kubernetesClusterNodePath, []interface{}{id}...)
 
-	return NewCollection[KubernetesClusterNode](h.client, path)
+	return NewCollection[KubernetesClusterNode](h.client, path).withOperation("KubernetesClusters.Nodes", kubernetesClusterNodePath, id)
 }
 
 // Get a Kubernetes cluster
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Kubernetes-Cluster/operation/GetAKubernetesCluster
 func (h *KubernetesClustersHandler) Get(ctx context.Context, id string) (*KubernetesCluster, error) {
+	ctx = withOperation(ctx, "KubernetesClusters.Get", kubernetesClusterPathWithID, id)
 	url := h.client.buildURL(kubernetesClusterPathWithID, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -71,6 +72,7 @@ func (h *KubernetesClustersHandler) Get(ctx context.Context, id string) (*Kubern
 // Get a node for a Kubernetes cluster
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Kubernetes-Cluster/operation/GetANodeForAKubernetesCluster
 func (h *KubernetesClustersHandler) GetNode(ctx context.Context, clusterID string, nodeID string) (*KubernetesClusterNode, error) {
+	ctx = withOperation(ctx, "KubernetesClusters.GetNode", kubernetesClusterNodePathWithID, clusterID, nodeID)
 	url := h.client.buildURL(kubernetesClusterNodePathWithID, []interface{}{clusterID, nodeID}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -96,6 +98,8 @@ func (h *KubernetesClustersHandler) Update(ctx context.Context, id string, input
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "KubernetesClusters.Update", kubernetesClusterPathWithID, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(kubernetesClusterPathWithID, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
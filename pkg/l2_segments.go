@@ -51,12 +51,13 @@ type L2SegmentsHandler struct {
 
 // Collection builds a new Collection[L2Segment] interface
 func (h *L2SegmentsHandler) Collection() Collection[L2Segment] {
-	return NewCollection[L2Segment](h.client, l2SegmentListPath)
+	return NewCollection[L2Segment](h.client, l2SegmentListPath).withOperation("L2Segments.Collection", l2SegmentListPath)
 }
 
 // Get l2 segment
 // Endpoint: https://developers.servers.com/api-documentation/v1/#operation/RetrieveAnExistingL2Segment
 func (h *L2SegmentsHandler) Get(ctx context.Context, segmentID string) (*L2Segment, error) {
+	ctx = withOperation(ctx, "L2Segments.Get", l2SegmentPath, segmentID)
 	url := h.client.buildURL(l2SegmentPath, []interface{}{segmentID}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -83,6 +84,8 @@ func (h *L2SegmentsHandler) Create(ctx context.Context, input L2SegmentCreateInp
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "L2Segments.Create", l2SegmentCreatePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(l2SegmentCreatePath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -109,6 +112,8 @@ func (h *L2SegmentsHandler) Update(ctx context.Context, segmentID string, input
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "L2Segments.Update", l2SegmentUpdatePath, segmentID)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(l2SegmentUpdatePath, []interface{}{segmentID}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -129,6 +134,7 @@ func (h *L2SegmentsHandler) Update(ctx context.Context, segmentID string, input
 // Delete l2 segment
 // Endpoint: https://developers.servers.com/api-documentation/v1/#operation/DeleteAnExistingL2Segment
 func (h *L2SegmentsHandler) Delete(ctx context.Context, segmentID string) error {
+	ctx = withOperation(ctx, "L2Segments.Delete", l2SegmentDeletePath, segmentID)
 	url := h.client.buildURL(l2SegmentDeletePath, []interface{}{segmentID}...)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -138,21 +144,21 @@ func (h *L2SegmentsHandler) Delete(ctx context.Context, segmentID string) error
 
 // LocationGroups builds a new Collection[L2LocationGroup] interface
 func (h *L2SegmentsHandler) LocationGroups() Collection[L2LocationGroup] {
-	return NewCollection[L2LocationGroup](h.client, l2LocationGroupListPath)
+	return NewCollection[L2LocationGroup](h.client, l2LocationGroupListPath).withOperation("L2Segments.LocationGroups", l2LocationGroupListPath)
 }
 
 // Members builds a new Collection[L2Member] interface
 func (h *L2SegmentsHandler) Members(segmentID string) Collection[L2Member] {
 	path := h.client.buildPath(l2MemberListPath, []interface{}{segmentID}...)
 
-	return NewCollection[L2Member](h.client, path)
+	return NewCollection[L2Member](h.client, path).withOperation("L2Segments.Members", l2MemberListPath, segmentID)
 }
 
 // Networks builds a new L2NetworksCollection interface
 func (h *L2SegmentsHandler) Networks(segmentID string) Collection[Network] {
 	path := h.client.buildPath(l2NetworksListPath, []interface{}{segmentID}...)
 
-	return NewCollection[Network](h.client, path)
+	return NewCollection[Network](h.client, path).withOperation("L2Segments.Networks", l2NetworksListPath, segmentID)
 }
 
 // ChangeNetworks changes networks set
@@ -164,6 +170,8 @@ func (h *L2SegmentsHandler) ChangeNetworks(ctx context.Context, segmentID string
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "L2Segments.ChangeNetworks", l2SegmentChangeNetworksPath, segmentID)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(l2SegmentChangeNetworksPath, []interface{}{segmentID}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -0,0 +1,307 @@
+// Package labels parses, builds and matches label selectors, which filter labeled resources
+// by the label_selector query param or on the client side.
+//
+//	selector, err := labels.Parse("env=prod,tier in (web,api),!deprecated")
+//	if err != nil {
+//		return err
+//	}
+//
+//	selector.String()              // env=prod,tier in (api,web),!deprecated
+//	selector.Matches(server.Labels) // true if all requirements are satisfied
+package labels
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// QueryParam is a name of the query param which carries a selector
+const QueryParam = "label_selector"
+
+// Operator represents a requirement operator
+type Operator string
+
+const (
+	// Equals requires a label with the value: key=value
+	Equals Operator = "="
+
+	// NotEquals requires a missing label or a label with another value: key!=value
+	NotEquals Operator = "!="
+
+	// In requires a label with one of the values: key in (a,b)
+	In Operator = "in"
+
+	// NotIn requires a missing label or a label with none of the values: key notin (a,b)
+	NotIn Operator = "notin"
+
+	// Exists requires a label with any value: key
+	Exists Operator = "exists"
+
+	// DoesNotExist requires a missing label: !key
+	DoesNotExist Operator = "!"
+)
+
+var (
+	keyRegexp          = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_./]*[A-Za-z0-9])?$`)
+	valueRegexp        = regexp.MustCompile(`^([A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?)?$`)
+	setRequirementExpr = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+)
+
+const maxValueLength = 63
+
+// Requirement represents a single condition of a selector
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// NewRequirement builds a requirement and checks the key, the values and their number for the operator
+func NewRequirement(key string, operator Operator, values ...string) (Requirement, error) {
+	if !keyRegexp.MatchString(key) {
+		return Requirement{}, fmt.Errorf("Invalid label key: %q", key)
+	}
+
+	switch operator {
+	case Equals, NotEquals:
+		if len(values) != 1 {
+			return Requirement{}, fmt.Errorf("Operator %s requires exactly one value for key %s", operator, key)
+		}
+	case In, NotIn:
+		if len(values) == 0 {
+			return Requirement{}, fmt.Errorf("Operator %s requires at least one value for key %s", operator, key)
+		}
+
+		for _, value := range values {
+			if value == "" {
+				return Requirement{}, fmt.Errorf("Operator %s requires non-empty values for key %s", operator, key)
+			}
+		}
+	case Exists, DoesNotExist:
+		if len(values) != 0 {
+			return Requirement{}, fmt.Errorf("Operator %s doesn't take values for key %s", operator, key)
+		}
+	default:
+		return Requirement{}, fmt.Errorf("Unknown operator: %q", operator)
+	}
+
+	for _, value := range values {
+		if len(value) > maxValueLength || !valueRegexp.MatchString(value) {
+			return Requirement{}, fmt.Errorf("Invalid label value: %q", value)
+		}
+	}
+
+	requirement := Requirement{Key: key, Operator: operator}
+
+	if len(values) > 0 {
+		requirement.Values = append([]string(nil), values...)
+		sort.Strings(requirement.Values)
+	}
+
+	return requirement, nil
+}
+
+// String returns the requirement in the selector syntax
+func (r Requirement) String() string {
+	switch r.Operator {
+	case Exists:
+		return r.Key
+	case DoesNotExist:
+		return "!" + r.Key
+	case In, NotIn:
+		return r.Key + " " + string(r.Operator) + " (" + strings.Join(r.Values, ",") + ")"
+	default:
+		return r.Key + string(r.Operator) + strings.Join(r.Values, "")
+	}
+}
+
+// Matches reports whether the labels satisfy the requirement
+func (r Requirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+
+	switch r.Operator {
+	case Equals:
+		return ok && value == r.Values[0]
+	case NotEquals:
+		return !ok || value != r.Values[0]
+	case In:
+		return ok && r.hasValue(value)
+	case NotIn:
+		return !ok || !r.hasValue(value)
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	default:
+		return false
+	}
+}
+
+func (r Requirement) hasValue(value string) bool {
+	for _, v := range r.Values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Selector is a list of requirements which all must be satisfied, an empty selector matches everything
+type Selector []Requirement
+
+// New builds a selector from requirements, it returns an error if any requirement is invalid
+func New(requirements ...Requirement) (Selector, error) {
+	selector := make(Selector, 0, len(requirements))
+
+	for _, r := range requirements {
+		checked, err := NewRequirement(r.Key, r.Operator, r.Values...)
+		if err != nil {
+			return nil, err
+		}
+
+		selector = append(selector, checked)
+	}
+
+	return selector, nil
+}
+
+// Parse parses a selector like: env=prod,tier in (web,api),!deprecated
+//
+// Supported operators are =, ==, !=, in, notin, exists (key) and does not exist (!key).
+func Parse(s string) (Selector, error) {
+	var selector Selector
+
+	parts, err := splitRequirements(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, part := range parts {
+		requirement, err := parseRequirement(part)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid label selector %q: %w", s, err)
+		}
+
+		selector = append(selector, requirement)
+	}
+
+	return selector, nil
+}
+
+// MustParse is like Parse but panics if the selector is invalid
+func MustParse(s string) Selector {
+	selector, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return selector
+}
+
+// String returns the selector in the syntax accepted by Parse and by the API
+func (s Selector) String() string {
+	parts := make([]string, 0, len(s))
+
+	for _, r := range s {
+		parts = append(parts, r.String())
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// Empty reports whether the selector has no requirements
+func (s Selector) Empty() bool {
+	return len(s) == 0
+}
+
+// Matches reports whether the labels satisfy all requirements of the selector
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Add returns a new selector with the requirements appended
+func (s Selector) Add(requirements ...Requirement) (Selector, error) {
+	added, err := New(requirements...)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append(Selector(nil), s...), added...), nil
+}
+
+// splitRequirements splits a selector by commas outside of parentheses
+func splitRequirements(s string) ([]string, error) {
+	var (
+		parts []string
+		depth int
+		start int
+	)
+
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+
+		if depth < 0 || depth > 1 {
+			return nil, fmt.Errorf("Invalid label selector %q: unbalanced parentheses", s)
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("Invalid label selector %q: unbalanced parentheses", s)
+	}
+
+	return append(parts, s[start:]), nil
+}
+
+func parseRequirement(s string) (Requirement, error) {
+	s = strings.TrimSpace(s)
+
+	if match := setRequirementExpr.FindStringSubmatch(s); match != nil {
+		var values []string
+
+		for _, value := range strings.Split(match[3], ",") {
+			values = append(values, strings.TrimSpace(value))
+		}
+
+		return NewRequirement(match[1], Operator(match[2]), values...)
+	}
+
+	for _, operator := range []string{"!=", "==", "="} {
+		if key, value, ok := strings.Cut(s, operator); ok {
+			op := Operator(operator)
+			if op == "==" {
+				op = Equals
+			}
+
+			return NewRequirement(strings.TrimSpace(key), op, strings.TrimSpace(value))
+		}
+	}
+
+	if key, ok := strings.CutPrefix(s, "!"); ok {
+		return NewRequirement(strings.TrimSpace(key), DoesNotExist)
+	}
+
+	return NewRequirement(s, Exists)
+}
@@ -0,0 +1,86 @@
+package labels
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	selector, err := Parse("env=prod, tier in (web, api),!deprecated,owner!=bob,region==eu,backup,zone notin (a,b)")
+	g.Expect(err).To(BeNil())
+	g.Expect(selector).To(Equal(Selector{
+		{Key: "env", Operator: Equals, Values: []string{"prod"}},
+		{Key: "tier", Operator: In, Values: []string{"api", "web"}},
+		{Key: "deprecated", Operator: DoesNotExist},
+		{Key: "owner", Operator: NotEquals, Values: []string{"bob"}},
+		{Key: "region", Operator: Equals, Values: []string{"eu"}},
+		{Key: "backup", Operator: Exists},
+		{Key: "zone", Operator: NotIn, Values: []string{"a", "b"}},
+	}))
+	g.Expect(selector.String()).To(Equal("env=prod,tier in (api,web),!deprecated,owner!=bob,region=eu,backup,zone notin (a,b)"))
+
+	empty, err := Parse("  ")
+	g.Expect(err).To(BeNil())
+	g.Expect(empty.Empty()).To(BeTrue())
+	g.Expect(empty.String()).To(BeEmpty())
+}
+
+func TestParseErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	for _, s := range []string{
+		"env=prod,",
+		"tier in (web",
+		"tier in ()",
+		"env=pr od",
+		"=prod",
+		"!",
+		"tier in ((web))",
+	} {
+		_, err := Parse(s)
+		g.Expect(err).NotTo(BeNil(), s)
+	}
+
+	g.Expect(func() { MustParse("env=") }).NotTo(Panic())
+	g.Expect(func() { MustParse("env in x") }).To(Panic())
+}
+
+func TestSelectorMatches(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	selector := MustParse("env=prod,tier in (web,api),!deprecated,owner!=bob")
+
+	g.Expect(selector.Matches(map[string]string{"env": "prod", "tier": "web"})).To(BeTrue())
+	g.Expect(selector.Matches(map[string]string{"env": "prod", "tier": "api", "owner": "alice"})).To(BeTrue())
+	g.Expect(selector.Matches(map[string]string{"env": "prod", "tier": "db"})).To(BeFalse())
+	g.Expect(selector.Matches(map[string]string{"env": "prod", "tier": "web", "deprecated": ""})).To(BeFalse())
+	g.Expect(selector.Matches(map[string]string{"env": "prod", "tier": "web", "owner": "bob"})).To(BeFalse())
+	g.Expect(selector.Matches(nil)).To(BeFalse())
+
+	g.Expect(Selector{}.Matches(nil)).To(BeTrue())
+	g.Expect(MustParse("zone notin (a)").Matches(nil)).To(BeTrue())
+}
+
+func TestNewSelector(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	selector, err := New(
+		Requirement{Key: "env", Operator: Equals, Values: []string{"prod"}},
+		Requirement{Key: "tier", Operator: In, Values: []string{"web", "api"}},
+	)
+	g.Expect(err).To(BeNil())
+	g.Expect(selector.String()).To(Equal("env=prod,tier in (api,web)"))
+
+	selector, err = selector.Add(Requirement{Key: "deprecated", Operator: DoesNotExist})
+	g.Expect(err).To(BeNil())
+	g.Expect(selector.String()).To(Equal("env=prod,tier in (api,web),!deprecated"))
+
+	_, err = New(Requirement{Key: "env", Operator: Equals})
+	g.Expect(err).To(MatchError("Operator = requires exactly one value for key env"))
+
+	_, err = NewRequirement("env", Operator("~"), "prod")
+	g.Expect(err).To(MatchError(`Unknown operator: "~"`))
+}
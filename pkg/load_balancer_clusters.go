@@ -27,11 +27,12 @@ type LoadBalancerClustersHandler struct {
 
 // Collection builds a new Collection[LoadBalancer] interface
 func (h *LoadBalancerClustersHandler) Collection() Collection[LoadBalancerCluster] {
-	return NewCollection[LoadBalancerCluster](h.client, loadBalancerClusterListPath)
+	return NewCollection[LoadBalancerCluster](h.client, loadBalancerClusterListPath).withOperation("LoadBalancerClusters.Collection", loadBalancerClusterListPath)
 }
 
 // GetLoadBalancerCluster returns a load balancer cluster
 func (h *LoadBalancerClustersHandler) GetLoadBalancerCluster(ctx context.Context, id string) (*LoadBalancerCluster, error) {
+	ctx = withOperation(ctx, "LoadBalancerClusters.GetLoadBalancerCluster", LoadBalancerClusterPath, id)
 	url := h.client.buildURL(LoadBalancerClusterPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
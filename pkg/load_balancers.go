@@ -43,12 +43,13 @@ type LoadBalancersHandler struct {
 
 // Collection builds a new Collection[LoadBalancer] interface
 func (h *LoadBalancersHandler) Collection() Collection[LoadBalancer] {
-	return NewCollection[LoadBalancer](h.client, loadBalancerListPath)
+	return NewCollection[LoadBalancer](h.client, loadBalancerListPath).withOperation("LoadBalancers.Collection", loadBalancerListPath)
 }
 
 // GetL4LoadBalancer returns a l4 load balancer
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Load-Balancer/operation/RetrieveAnExisitingL4LoadBalancer
 func (h *LoadBalancersHandler) GetL4LoadBalancer(ctx context.Context, id string) (*L4LoadBalancer, error) {
+	ctx = withOperation(ctx, "LoadBalancers.GetL4LoadBalancer", l4LoadBalancerPath, id)
 	url := h.client.buildURL(l4LoadBalancerPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -75,6 +76,8 @@ func (h *LoadBalancersHandler) CreateL4LoadBalancer(ctx context.Context, input L
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "LoadBalancers.CreateL4LoadBalancer", l4LoadBalancerCreatePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(l4LoadBalancerCreatePath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -101,6 +104,8 @@ func (h *LoadBalancersHandler) UpdateL4LoadBalancer(ctx context.Context, id stri
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "LoadBalancers.UpdateL4LoadBalancer", l4LoadBalancerUpdatePath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(l4LoadBalancerUpdatePath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -121,6 +126,7 @@ func (h *LoadBalancersHandler) UpdateL4LoadBalancer(ctx context.Context, id stri
 // DeleteL4LoadBalancer deletes l4 load balancer
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Load-Balancer/operation/DeleteAnExistingL4LoadBalancer
 func (h *LoadBalancersHandler) DeleteL4LoadBalancer(ctx context.Context, id string) error {
+	ctx = withOperation(ctx, "LoadBalancers.DeleteL4LoadBalancer", l4LoadBalancerDeletePath, id)
 	url := h.client.buildURL(l4LoadBalancerDeletePath, []interface{}{id}...)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -131,6 +137,7 @@ func (h *LoadBalancersHandler) DeleteL4LoadBalancer(ctx context.Context, id stri
 // GetL7LoadBalancer returns a l7 load balancer
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Load-Balancer/operation/RetrieveAnExistingL7LoadBalancer
 func (h *LoadBalancersHandler) GetL7LoadBalancer(ctx context.Context, id string) (*L7LoadBalancer, error) {
+	ctx = withOperation(ctx, "LoadBalancers.GetL7LoadBalancer", l7LoadBalancerPath, id)
 	url := h.client.buildURL(l7LoadBalancerPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -157,6 +164,8 @@ func (h *LoadBalancersHandler) CreateL7LoadBalancer(ctx context.Context, input L
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "LoadBalancers.CreateL7LoadBalancer", l7LoadBalancerCreatePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(l7LoadBalancerCreatePath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -183,6 +192,8 @@ func (h *LoadBalancersHandler) UpdateL7LoadBalancer(ctx context.Context, id stri
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "LoadBalancers.UpdateL7LoadBalancer", l7LoadBalancerUpdatePath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(l7LoadBalancerUpdatePath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -203,6 +214,7 @@ func (h *LoadBalancersHandler) UpdateL7LoadBalancer(ctx context.Context, id stri
 // DeleteL7LoadBalancer deletes l7 load balancer
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Load-Balancer/operation/DeleteAnExistingL7LoadBalancer
 func (h *LoadBalancersHandler) DeleteL7LoadBalancer(ctx context.Context, id string) error {
+	ctx = withOperation(ctx, "LoadBalancers.DeleteL7LoadBalancer", l7LoadBalancerDeletePath, id)
 	url := h.client.buildURL(l7LoadBalancerDeletePath, []interface{}{id}...)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
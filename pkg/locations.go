@@ -78,11 +78,12 @@ type LocationsHandler struct {
 
 // Collection builds a new LocationsCollection interface
 func (h *LocationsHandler) Collection() Collection[Location] {
-	return NewCollection[Location](h.client, locationListPath)
+	return NewCollection[Location](h.client, locationListPath).withOperation("Locations.Collection", locationListPath)
 }
 
 // GetLocation returns a location
 func (h *LocationsHandler) GetLocation(ctx context.Context, id int64) (*Location, error) {
+	ctx = withOperation(ctx, "Locations.GetLocation", locationPath, id)
 	url := h.client.buildURL(locationPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -102,11 +103,12 @@ func (h *LocationsHandler) GetLocation(ctx context.Context, id int64) (*Location
 func (h *LocationsHandler) ServerModelOptions(LocationID int64) Collection[ServerModelOption] {
 	path := h.client.buildPath(serverModelOptionListPath, []interface{}{LocationID}...)
 
-	return NewCollection[ServerModelOption](h.client, path)
+	return NewCollection[ServerModelOption](h.client, path).withOperation("Locations.ServerModelOptions", serverModelOptionListPath, LocationID)
 }
 
 // GetServerModelOption returns a server model option
 func (h *LocationsHandler) GetServerModelOption(ctx context.Context, locationID, serverModelID int64) (*ServerModelOptionDetail, error) {
+	ctx = withOperation(ctx, "Locations.GetServerModelOption", serverModelOptionPath, locationID, serverModelID)
 	url := h.client.buildURL(serverModelOptionPath, locationID, serverModelID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -126,18 +128,19 @@ func (h *LocationsHandler) GetServerModelOption(ctx context.Context, locationID,
 func (h *LocationsHandler) RAMOptions(LocationID, ServerModelID int64) Collection[RAMOption] {
 	path := h.client.buildPath(ramOptionListPath, []interface{}{LocationID, ServerModelID}...)
 
-	return NewCollection[RAMOption](h.client, path)
+	return NewCollection[RAMOption](h.client, path).withOperation("Locations.RAMOptions", ramOptionListPath, LocationID, ServerModelID)
 }
 
 // OperatingSystemOptions builds a new Collection[OperatingSystemOption] interface
 func (h *LocationsHandler) OperatingSystemOptions(LocationID, ServerModelID int64) Collection[OperatingSystemOption] {
 	path := h.client.buildPath(operatingSystemOptionListPath, []interface{}{LocationID, ServerModelID}...)
 
-	return NewCollection[OperatingSystemOption](h.client, path)
+	return NewCollection[OperatingSystemOption](h.client, path).withOperation("Locations.OperatingSystemOptions", operatingSystemOptionListPath, LocationID, ServerModelID)
 }
 
 // GetOperatingSystemOption returns an operating system option
 func (h *LocationsHandler) GetOperatingSystemOption(ctx context.Context, locationID, serverModelID, operatingSystemID int64) (*OperatingSystemOption, error) {
+	ctx = withOperation(ctx, "Locations.GetOperatingSystemOption", operatingSystemOptionPath, locationID, serverModelID, operatingSystemID)
 	url := h.client.buildURL(operatingSystemOptionPath, locationID, serverModelID, operatingSystemID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -157,11 +160,12 @@ func (h *LocationsHandler) GetOperatingSystemOption(ctx context.Context, locatio
 func (h *LocationsHandler) DriveModelOptions(LocationID, ServerModelID int64) Collection[DriveModel] {
 	path := h.client.buildPath(driveModelListPath, []interface{}{LocationID, ServerModelID}...)
 
-	return NewCollection[DriveModel](h.client, path)
+	return NewCollection[DriveModel](h.client, path).withOperation("Locations.DriveModelOptions", driveModelListPath, LocationID, ServerModelID)
 }
 
 // GetDriveModelOption returns a drive model
 func (h *LocationsHandler) GetDriveModelOption(ctx context.Context, locationID, serverModelID, driveModelID int64) (*DriveModel, error) {
+	ctx = withOperation(ctx, "Locations.GetDriveModelOption", driveModelPath, locationID, serverModelID, driveModelID)
 	url := h.client.buildURL(driveModelPath, locationID, serverModelID, driveModelID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -181,11 +185,12 @@ func (h *LocationsHandler) GetDriveModelOption(ctx context.Context, locationID,
 func (h *LocationsHandler) UplinkOptions(LocationID, ServerModelID int64) Collection[UplinkOption] {
 	path := h.client.buildPath(uplinkOptionListPath, []interface{}{LocationID, ServerModelID}...)
 
-	return NewCollection[UplinkOption](h.client, path)
+	return NewCollection[UplinkOption](h.client, path).withOperation("Locations.UplinkOptions", uplinkOptionListPath, LocationID, ServerModelID)
 }
 
 // GetUplinkOption returns an uplink model
 func (h *LocationsHandler) GetUplinkOption(ctx context.Context, locationID, serverModelID, uplinkModelID int64) (*UplinkOption, error) {
+	ctx = withOperation(ctx, "Locations.GetUplinkOption", uplinkOptionPath, locationID, serverModelID, uplinkModelID)
 	url := h.client.buildURL(uplinkOptionPath, locationID, serverModelID, uplinkModelID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -205,11 +210,12 @@ func (h *LocationsHandler) GetUplinkOption(ctx context.Context, locationID, serv
 func (h *LocationsHandler) BandwidthOptions(LocationID, ServerModelID, uplinkID int64) Collection[BandwidthOption] {
 	path := h.client.buildPath(bandwidthOptionListPath, []interface{}{LocationID, ServerModelID, uplinkID}...)
 
-	return NewCollection[BandwidthOption](h.client, path)
+	return NewCollection[BandwidthOption](h.client, path).withOperation("Locations.BandwidthOptions", bandwidthOptionListPath, LocationID, ServerModelID, uplinkID)
 }
 
 // GetBandwidthOption returns a bandwidth option
 func (h *LocationsHandler) GetBandwidthOption(ctx context.Context, locationID, serverModelID, uplinkModelID, bandwidthID int64) (*BandwidthOption, error) {
+	ctx = withOperation(ctx, "Locations.GetBandwidthOption", bandwidthOptionPath, locationID, serverModelID, uplinkModelID, bandwidthID)
 	url := h.client.buildURL(bandwidthOptionPath, locationID, serverModelID, uplinkModelID, bandwidthID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -229,11 +235,12 @@ func (h *LocationsHandler) GetBandwidthOption(ctx context.Context, locationID, s
 func (h *LocationsHandler) SBMFlavorOptions(LocationID int64) Collection[SBMFlavor] {
 	path := h.client.buildPath(sbmFlavorOptionListPath, []interface{}{LocationID}...)
 
-	return NewCollection[SBMFlavor](h.client, path)
+	return NewCollection[SBMFlavor](h.client, path).withOperation("Locations.SBMFlavorOptions", sbmFlavorOptionListPath, LocationID)
 }
 
 // GetSBMFlavorOption returns an SBM flavor model
 func (h *LocationsHandler) GetSBMFlavorOption(ctx context.Context, locationID, sbmFlavorModelID int64) (*SBMFlavor, error) {
+	ctx = withOperation(ctx, "Locations.GetSBMFlavorOption", sbmFlavorOptionPath, locationID, sbmFlavorModelID)
 	url := h.client.buildURL(sbmFlavorOptionPath, locationID, sbmFlavorModelID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -253,11 +260,12 @@ func (h *LocationsHandler) GetSBMFlavorOption(ctx context.Context, locationID, s
 func (h *LocationsHandler) SBMOperatingSystemOptions(LocationID, SBMFlavorModelID int64) Collection[OperatingSystemOption] {
 	path := h.client.buildPath(sbmOperatingSystemOptionListPath, []interface{}{LocationID, SBMFlavorModelID}...)
 
-	return NewCollection[OperatingSystemOption](h.client, path)
+	return NewCollection[OperatingSystemOption](h.client, path).withOperation("Locations.SBMOperatingSystemOptions", sbmOperatingSystemOptionListPath, LocationID, SBMFlavorModelID)
 }
 
 // GetSBMOperatingSystemOption returns an SBM operating system option
 func (h *LocationsHandler) GetSBMOperatingSystemOption(ctx context.Context, locationID, sbmFlavorModelID, operatingSystemID int64) (*OperatingSystemOption, error) {
+	ctx = withOperation(ctx, "Locations.GetSBMOperatingSystemOption", sbmOperatingSystemOptionPath, locationID, sbmFlavorModelID, operatingSystemID)
 	url := h.client.buildURL(sbmOperatingSystemOptionPath, locationID, sbmFlavorModelID, operatingSystemID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -276,11 +284,12 @@ func (h *LocationsHandler) GetSBMOperatingSystemOption(ctx context.Context, loca
 // RemoteBlockStorageFlavors builds a new Collection[RemoteBlockStorageFlavor] interface
 func (h *LocationsHandler) RemoteBlockStorageFlavors(locationID int64) Collection[RemoteBlockStorageFlavor] {
 	path := h.client.buildPath(remoteBlockStorageFlavorListPath, []interface{}{locationID}...)
-	return NewCollection[RemoteBlockStorageFlavor](h.client, path)
+	return NewCollection[RemoteBlockStorageFlavor](h.client, path).withOperation("Locations.RemoteBlockStorageFlavors", remoteBlockStorageFlavorListPath, locationID)
 }
 
 // GetRemoteBlockStorageFlavor returns an RBS flavor detail
 func (h *LocationsHandler) GetRemoteBlockStorageFlavor(ctx context.Context, locationID, flavorID int64) (*RemoteBlockStorageFlavor, error) {
+	ctx = withOperation(ctx, "Locations.GetRemoteBlockStorageFlavor", remoteBlockStorageFlavorPath, locationID, flavorID)
 	url := h.client.buildURL(remoteBlockStorageFlavorPath, locationID, flavorID)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -0,0 +1,180 @@
+package serverscom
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/serverscom/serverscom-go-client/pkg/internal/redact"
+)
+
+// redactor hides values of sensitive headers and known secret fields of bodies, e.g.
+// DedicatedServerOOBCredentials.Secret and SSLCertificateCreateCustomInput.PrivateKey
+var redactor = redact.New()
+
+// LogLevels describes levels of events emitted by a client configured with WithLogger
+type LogLevels struct {
+	// Request is a level of an event emitted before each request attempt
+	Request slog.Level
+
+	// Response is a level of an event emitted after each successful response
+	Response slog.Level
+
+	// Error is a level of an event emitted after each transport error or response with status code >= 400
+	Error slog.Level
+
+	// Planned is a level of an event emitted for each operation planned in dry-run mode, see WithDryRun
+	Planned slog.Level
+}
+
+// DefaultLogLevels returns levels used by WithLogger: requests and responses are logged at debug level
+// and failures at warn level.
+func DefaultLogLevels() LogLevels {
+	return LogLevels{
+		Request:  slog.LevelDebug,
+		Response: slog.LevelDebug,
+		Error:    slog.LevelWarn,
+		Planned:  slog.LevelInfo,
+	}
+}
+
+// WithLogger enables structured logging of requests and responses.
+//
+// Authorization header and known secret fields of bodies, such as OOB credentials, volume passwords
+// and SSL private keys, are redacted before anything is logged. Use it instead of SetVerbose.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *clientOptions) {
+		o.logger = logger
+	}
+}
+
+// WithLogLevels sets levels of events emitted by the logger set by WithLogger, by default DefaultLogLevels is used
+func WithLogLevels(levels LogLevels) Option {
+	return func(o *clientOptions) {
+		o.logLevels = &levels
+	}
+}
+
+type logging struct {
+	logger *slog.Logger
+	levels LogLevels
+}
+
+func newLogging(logger *slog.Logger, levels *LogLevels) logging {
+	l := logging{
+		logger: logger,
+		levels: DefaultLogLevels(),
+	}
+
+	if levels != nil {
+		l.levels = *levels
+	}
+
+	return l
+}
+
+func (l logging) enabled(ctx context.Context, level slog.Level) bool {
+	return l.logger != nil && l.logger.Enabled(ctx, level)
+}
+
+// logRequest emits an event before a request attempt
+func (l logging) logRequest(ctx context.Context, req *Request, attempt int) {
+	if !l.enabled(ctx, l.levels.Request) {
+		return
+	}
+
+	attributes := append(
+		operationLogAttrs(ctx),
+		slog.String("method", req.Method),
+		slog.String("url", req.URL),
+		slog.Int("attempt", attempt),
+		slog.Any("headers", redactHeader(req.Header)),
+	)
+
+	if len(req.Body) > 0 {
+		attributes = append(attributes, slog.String("body", redactBody(req.Body)))
+	}
+
+	l.logger.LogAttrs(ctx, l.levels.Request, "serverscom request", attributes...)
+}
+
+// logResponse emits an event after a request attempt, failures are logged at the error level
+func (l logging) logResponse(ctx context.Context, req *Request, resp *Response, err error, duration time.Duration) {
+	level := l.levels.Response
+	if err != nil || resp.StatusCode >= 400 {
+		level = l.levels.Error
+	}
+
+	if !l.enabled(ctx, level) {
+		return
+	}
+
+	attributes := append(
+		operationLogAttrs(ctx),
+		slog.String("method", req.Method),
+		slog.String("url", req.URL),
+		slog.Duration("duration", duration),
+	)
+
+	if err != nil {
+		attributes = append(attributes, slog.String("error", err.Error()))
+		l.logger.LogAttrs(ctx, level, "serverscom request failed", attributes...)
+
+		return
+	}
+
+	attributes = append(attributes, slog.Int("status_code", resp.StatusCode))
+
+	if len(resp.Body) > 0 {
+		attributes = append(attributes, slog.String("body", redactBody(resp.Body)))
+	}
+
+	l.logger.LogAttrs(ctx, level, "serverscom response", attributes...)
+}
+
+// logPlannedOperation emits an event for an operation planned in dry-run mode, its body is already redacted
+func (l logging) logPlannedOperation(ctx context.Context, operation PlannedOperation) {
+	if !l.enabled(ctx, l.levels.Planned) {
+		return
+	}
+
+	attributes := append(
+		operationLogAttrs(ctx),
+		slog.String("method", operation.Method),
+		slog.String("url", operation.URL),
+	)
+
+	if len(operation.Body) > 0 {
+		attributes = append(attributes, slog.String("body", string(operation.Body)))
+	}
+
+	l.logger.LogAttrs(ctx, l.levels.Planned, "serverscom planned operation", attributes...)
+}
+
+func operationLogAttrs(ctx context.Context) []slog.Attr {
+	op, ok := operationFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return []slog.Attr{slog.String("operation", op.Name)}
+}
+
+// redactHeader returns a copy of the header with values of sensitive headers replaced
+func redactHeader(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+
+	for key, values := range redactor.Header(header) {
+		result[key] = strings.Join(values, ", ")
+	}
+
+	return result
+}
+
+// redactBody returns the body with values of sensitive JSON fields replaced,
+// bodies which aren't valid JSON are logged as is.
+func redactBody(body []byte) string {
+	return string(redactor.Body(body))
+}
@@ -0,0 +1,38 @@
+package serverscom
+
+import (
+	"time"
+)
+
+// MetricsRecorder is an interface for receiving measurements of the client traffic,
+// the metrics subpackage provides a Prometheus implementation.
+//
+// Endpoint is a path template of the called method, e.g. /hosts/dedicated_servers/%s, so it's safe
+// to use it as a low cardinality label. Implementations must be safe for concurrent use.
+type MetricsRecorder interface {
+	// ObserveRequest is called once per API call, duration includes retries and rate limit waits.
+	// StatusCode is 0 when no response was received, errorType is empty for successful calls.
+	// Calls served from the cache without a request aren't observed.
+	ObserveRequest(method, endpoint string, statusCode int, errorType string, duration time.Duration)
+
+	// ObserveRetry is called before each retry of an API call
+	ObserveRetry(method, endpoint string)
+
+	// ObserveRateLimitWait is called after each wait on the client rate limiter
+	ObserveRateLimitWait(method, endpoint string, wait time.Duration)
+}
+
+// WithMetrics sets a recorder fed with measurements of the client traffic
+func WithMetrics(recorder MetricsRecorder) Option {
+	return func(o *clientOptions) {
+		o.metrics = recorder
+	}
+}
+
+type noopMetricsRecorder struct{}
+
+func (noopMetricsRecorder) ObserveRequest(string, string, int, string, time.Duration) {}
+
+func (noopMetricsRecorder) ObserveRetry(string, string) {}
+
+func (noopMetricsRecorder) ObserveRateLimitWait(string, string, time.Duration) {}
@@ -0,0 +1,104 @@
+// Package metrics provides a Prometheus collector for the Servers.com API client traffic.
+//
+//	collector := metrics.NewCollector()
+//	prometheus.MustRegister(collector)
+//
+//	client := serverscom.NewClientWithOptions(token, serverscom.WithMetrics(collector))
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	serverscom "github.com/serverscom/serverscom-go-client/pkg"
+)
+
+const (
+	namespace = "serverscom"
+	subsystem = "client"
+
+	noStatusCode = "none"
+)
+
+// Collector is a prometheus.Collector fed by a client configured with serverscom.WithMetrics
+type Collector struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	retries       *prometheus.CounterVec
+	rateLimitWait *prometheus.CounterVec
+}
+
+var (
+	_ prometheus.Collector       = (*Collector)(nil)
+	_ serverscom.MetricsRecorder = (*Collector)(nil)
+)
+
+// NewCollector builds a new Collector, it should be registered in a prometheus.Registerer
+func NewCollector() *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "requests_total",
+			Help:      "Number of API calls by method, endpoint template, status code and error type.",
+		}, []string{"method", "endpoint", "status_code", "error_type"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of API calls including retries and rate limit waits.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "endpoint"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "retries_total",
+			Help:      "Number of retried API requests.",
+		}, []string{"method", "endpoint"}),
+		rateLimitWait: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "rate_limit_wait_seconds_total",
+			Help:      "Time spent waiting on the client rate limiter.",
+		}, []string{"method", "endpoint"}),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.retries.Describe(ch)
+	c.rateLimitWait.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.retries.Collect(ch)
+	c.rateLimitWait.Collect(ch)
+}
+
+// ObserveRequest implements serverscom.MetricsRecorder
+func (c *Collector) ObserveRequest(method, endpoint string, statusCode int, errorType string, duration time.Duration) {
+	status := noStatusCode
+	if statusCode > 0 {
+		status = strconv.Itoa(statusCode)
+	}
+
+	c.requests.WithLabelValues(method, endpoint, status, errorType).Inc()
+	c.duration.WithLabelValues(method, endpoint).Observe(duration.Seconds())
+}
+
+// ObserveRetry implements serverscom.MetricsRecorder
+func (c *Collector) ObserveRetry(method, endpoint string) {
+	c.retries.WithLabelValues(method, endpoint).Inc()
+}
+
+// ObserveRateLimitWait implements serverscom.MetricsRecorder
+func (c *Collector) ObserveRateLimitWait(method, endpoint string, wait time.Duration) {
+	c.rateLimitWait.WithLabelValues(method, endpoint).Add(wait.Seconds())
+}
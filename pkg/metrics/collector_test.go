@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	serverscom "github.com/serverscom/serverscom-go-client/pkg"
+)
+
+func TestCollectorObservesClientTraffic(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	calls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case calls == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"code": "UNAVAILABLE", "message": "Maintenance"}`))
+		case strings.HasSuffix(r.URL.Path, "/missing"):
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code": "NOT_FOUND", "message": "Not found"}`))
+		default:
+			w.Write([]byte(`{"id": "a", "name": "key", "fingerprint": "fp"}`))
+		}
+	}))
+
+	defer ts.Close()
+
+	collector := NewCollector()
+
+	registry := prometheus.NewPedanticRegistry()
+	g.Expect(registry.Register(collector)).To(Succeed())
+
+	client := serverscom.NewClientWithOptions(
+		"token",
+		serverscom.WithBaseURL(ts.URL),
+		serverscom.WithMetrics(collector),
+		serverscom.WithRetryPolicy(&serverscom.RetryPolicy{
+			MaxAttempts: 2,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  time.Millisecond,
+			Methods:     []string{http.MethodGet},
+			StatusCodes: []int{http.StatusServiceUnavailable},
+		}),
+	)
+
+	ctx := context.TODO()
+
+	_, err := client.SSHKeys.Get(ctx, "fp")
+	g.Expect(err).To(BeNil())
+
+	_, err = client.SSHKeys.Get(ctx, "missing")
+	g.Expect(err).To(BeAssignableToTypeOf(&serverscom.NotFoundError{}))
+
+	expected := `
+# HELP serverscom_client_requests_total Number of API calls by method, endpoint template, status code and error type.
+# TYPE serverscom_client_requests_total counter
+serverscom_client_requests_total{endpoint="/ssh_keys/%s",error_type="",method="GET",status_code="200"} 1
+serverscom_client_requests_total{endpoint="/ssh_keys/%s",error_type="NotFoundError",method="GET",status_code="404"} 1
+# HELP serverscom_client_retries_total Number of retried API requests.
+# TYPE serverscom_client_retries_total counter
+serverscom_client_retries_total{endpoint="/ssh_keys/%s",method="GET"} 1
+`
+
+	g.Expect(testutil.GatherAndCompare(
+		registry,
+		strings.NewReader(expected),
+		"serverscom_client_requests_total",
+		"serverscom_client_retries_total",
+	)).To(Succeed())
+	g.Expect(testutil.CollectAndCount(collector, "serverscom_client_request_duration_seconds")).To(Equal(1))
+}
+
+func TestCollectorLabelsMissingStatusCode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	collector := NewCollector()
+
+	collector.ObserveRequest("GET", "/hosts", 0, "_OTHER", time.Second)
+	collector.ObserveRateLimitWait("GET", "/hosts", 250*time.Millisecond)
+
+	expected := `
+# HELP serverscom_client_rate_limit_wait_seconds_total Time spent waiting on the client rate limiter.
+# TYPE serverscom_client_rate_limit_wait_seconds_total counter
+serverscom_client_rate_limit_wait_seconds_total{endpoint="/hosts",method="GET"} 0.25
+# HELP serverscom_client_requests_total Number of API calls by method, endpoint template, status code and error type.
+# TYPE serverscom_client_requests_total counter
+serverscom_client_requests_total{endpoint="/hosts",error_type="_OTHER",method="GET",status_code="none"} 1
+`
+
+	g.Expect(testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(expected),
+		"serverscom_client_requests_total",
+		"serverscom_client_rate_limit_wait_seconds_total",
+	)).To(Succeed())
+}
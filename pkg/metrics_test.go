@@ -0,0 +1,134 @@
+package serverscom
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type observedRequest struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+	ErrorType  string
+}
+
+type fakeMetricsRecorder struct {
+	mu sync.Mutex
+
+	requests      []observedRequest
+	retries       []string
+	rateLimitWait time.Duration
+}
+
+func (r *fakeMetricsRecorder) ObserveRequest(method, endpoint string, statusCode int, errorType string, _ time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, observedRequest{method, endpoint, statusCode, errorType})
+}
+
+func (r *fakeMetricsRecorder) ObserveRetry(method, endpoint string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.retries = append(r.retries, method+" "+endpoint)
+}
+
+func (r *fakeMetricsRecorder) ObserveRateLimitWait(_, _ string, wait time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rateLimitWait += wait
+}
+
+func TestMetricsObserveRequestByEndpointTemplate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	recorder := &fakeMetricsRecorder{}
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers/" + serverID).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/hosts/dedicated_servers/get_response.json").
+		Next().
+		WithRequestPath("/hosts/dedicated_servers/" + serverID).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "NOT_FOUND", "message": "Server not found"}`).
+		WithResponseCode(404).
+		BuildWithOptions(WithMetrics(recorder))
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+
+	_, err := client.Hosts.GetDedicatedServer(ctx, serverID)
+	g.Expect(err).To(BeNil())
+
+	_, err = client.Hosts.GetDedicatedServer(ctx, serverID)
+	g.Expect(err).To(BeAssignableToTypeOf(&NotFoundError{}))
+
+	g.Expect(recorder.requests).To(Equal([]observedRequest{
+		{Method: "GET", Endpoint: "/hosts/dedicated_servers/%s", StatusCode: 200},
+		{Method: "GET", Endpoint: "/hosts/dedicated_servers/%s", StatusCode: 404, ErrorType: "NotFoundError"},
+	}))
+	g.Expect(recorder.retries).To(BeEmpty())
+	g.Expect(recorder.rateLimitWait).To(BeZero())
+}
+
+func TestMetricsObserveRetriesAndRateLimitWaits(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	recorder := &fakeMetricsRecorder{}
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/"+sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "UNAVAILABLE", "message": "Maintenance"}`).
+		WithResponseCode(503).
+		Next().
+		WithRequestPath("/ssh_keys/"+sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		BuildWithOptions(
+			WithMetrics(recorder),
+			WithRetryPolicy(testRetryPolicy()),
+			WithRateLimit(&RateLimit{RequestsPerSecond: 20, Burst: 1}),
+		)
+
+	defer ts.Close()
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(err).To(BeNil())
+
+	g.Expect(recorder.requests).To(Equal([]observedRequest{
+		{Method: "GET", Endpoint: "/ssh_keys/%s", StatusCode: 200},
+	}))
+	g.Expect(recorder.retries).To(Equal([]string{"GET /ssh_keys/%s"}))
+	g.Expect(recorder.rateLimitWait).To(BeNumerically(">", 0))
+}
+
+func TestMetricsObserveTransportError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	recorder := &fakeMetricsRecorder{}
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	client := NewClientWithOptions("token", WithTransport(transport), WithMetrics(recorder))
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("connection refused"))
+
+	g.Expect(recorder.requests).To(Equal([]observedRequest{
+		{Method: "GET", Endpoint: "/ssh_keys/%s", ErrorType: "_OTHER"},
+	}))
+}
@@ -0,0 +1,72 @@
+package serverscom
+
+import (
+	"context"
+	"net/http"
+)
+
+// Request represents an API request passed through the middleware chain
+type Request struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+// Response represents an API response passed through the middleware chain
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Handler performs a request, it must return either a response or an error
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a Handler, it can modify the request before calling the next handler,
+// inspect or replace the response and short-circuit the chain by not calling the next handler at all.
+type Middleware func(next Handler) Handler
+
+// Use appends middlewares to the client chain, the first appended middleware is the outermost one.
+//
+// Middlewares wrap every attempt of every request made by services and collections,
+// so a retried request passes through the chain several times.
+func (cli *Client) Use(middlewares ...Middleware) {
+	cli.middlewares = append(cli.middlewares, middlewares...)
+}
+
+func (cli *Client) handler() Handler {
+	handler := cli.transport
+
+	for i := len(cli.middlewares) - 1; i >= 0; i-- {
+		handler = cli.middlewares[i](handler)
+	}
+
+	return handler
+}
+
+// transport is the innermost handler which sends a request to the API
+func (cli *Client) transport(ctx context.Context, req *Request) (*Response, error) {
+	request := cli.client.R().SetContext(ctx)
+
+	for name, values := range req.Header {
+		for _, value := range values {
+			request.Header.Add(name, value)
+		}
+	}
+
+	if req.Body != nil {
+		request.SetBody(req.Body)
+	}
+
+	resp, err := request.Execute(req.Method, req.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		StatusCode: resp.StatusCode(),
+		Header:     resp.Header(),
+		Body:       resp.Body(),
+	}, nil
+}
@@ -0,0 +1,156 @@
+package serverscom
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestMiddlewareSeesRequestAndResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var (
+		requests  []Request
+		responses []Response
+	)
+
+	audit := func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			requests = append(requests, *req)
+
+			resp, err := next(ctx, req)
+			if err == nil {
+				responses = append(responses, *resp)
+			}
+
+			return resp, err
+		}
+	}
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys").
+		WithRequestMethod("POST").
+		WithResponseBodyStubFile("fixtures/ssh_keys/create_response.json").
+		WithResponseCode(201).
+		BuildWithOptions(WithMiddleware(audit))
+
+	defer ts.Close()
+
+	input := SSHKeyCreateInput{Name: "test-key", PublicKey: sshPublicKey}
+
+	_, err := client.SSHKeys.Create(context.TODO(), input)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].Method).To(Equal("POST"))
+	g.Expect(requests[0].URL).To(Equal(ts.Server.URL + "/v1/ssh_keys"))
+	g.Expect(string(requests[0].Body)).To(ContainSubstring(`"name":"test-key"`))
+	g.Expect(requests[0].Header.Get("Authorization")).To(Equal("Bearer testing_token"))
+
+	g.Expect(responses).To(HaveLen(1))
+	g.Expect(responses[0].StatusCode).To(Equal(201))
+	g.Expect(responses[0].Header.Get("Content-Type")).To(Equal("application/json"))
+	g.Expect(string(responses[0].Body)).To(ContainSubstring(sshFingerprint))
+}
+
+func TestMiddlewareInjectsHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var tenants []string
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		tenants = append(tenants, r.Header.Get("X-Tenant-Id"))
+
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	tenant := func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			req.Header.Set("X-Tenant-Id", "tenant-1")
+
+			return next(ctx, req)
+		}
+	}
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=2&per_page=1>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "a"}]`).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`[{"id": "b"}]`).
+		BuildWithOptions(WithTransport(transport))
+
+	defer ts.Close()
+
+	client.Use(tenant)
+
+	list, err := client.Hosts.Collection().Collect(context.TODO())
+
+	g.Expect(err).To(BeNil())
+	g.Expect(list).To(HaveLen(2))
+	g.Expect(tenants).To(Equal([]string{"tenant-1", "tenant-1"}))
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var calls []string
+
+	named := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				calls = append(calls, name+":before")
+				resp, err := next(ctx, req)
+				calls = append(calls, name+":after")
+
+				return resp, err
+			}
+		}
+	}
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("DELETE").
+		WithResponseCode(204).
+		Build()
+
+	defer ts.Close()
+
+	client.Use(named("outer"), named("inner"))
+
+	g.Expect(client.SSHKeys.Delete(context.TODO(), sshFingerprint)).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"outer:before", "inner:before", "inner:after", "outer:after"}))
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	stub := func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			if !strings.HasSuffix(req.URL, "/ssh_keys/"+sshFingerprint) {
+				return next(ctx, req)
+			}
+
+			return &Response{
+				StatusCode: 404,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       []byte(`{"code": "NOT_FOUND", "message": "SSH key not found"}`),
+			}, nil
+		}
+	}
+
+	client := NewClientWithOptions("token", WithBaseURL("http://127.0.0.1:0/v1"), WithMiddleware(stub))
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+
+	g.Expect(err).To(BeAssignableToTypeOf(&NotFoundError{}))
+	g.Expect(err.Error()).To(Equal("Not found: SSH key not found"))
+}
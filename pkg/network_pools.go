@@ -41,12 +41,13 @@ type NetworkPoolsHandler struct {
 
 // Collection builds a new Collection[NetworkPool] interface
 func (h *NetworkPoolsHandler) Collection() Collection[NetworkPool] {
-	return NewCollection[NetworkPool](h.client, networkPoolListPath)
+	return NewCollection[NetworkPool](h.client, networkPoolListPath).withOperation("NetworkPools.Collection", networkPoolListPath)
 }
 
 // Get returns a network pool
 // Endpoint: https://developers.servers.com/api-documentation/v1/#operation/RetrieveAnExistingNetworkPool
 func (h *NetworkPoolsHandler) Get(ctx context.Context, id string) (*NetworkPool, error) {
+	ctx = withOperation(ctx, "NetworkPools.Get", networkPoolPath, id)
 	url := h.client.buildURL(networkPoolPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -71,6 +72,8 @@ func (h *NetworkPoolsHandler) Update(ctx context.Context, id string, input Netwo
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "NetworkPools.Update", networkPoolPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(networkPoolPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -95,6 +98,8 @@ func (h *NetworkPoolsHandler) CreateSubnetwork(ctx context.Context, networkPoolI
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "NetworkPools.CreateSubnetwork", subnetworkCreatePath, networkPoolID)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(subnetworkCreatePath, []interface{}{networkPoolID}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -114,6 +119,7 @@ func (h *NetworkPoolsHandler) CreateSubnetwork(ctx context.Context, networkPoolI
 // GetSubnetwork returns subnetwork from the pool
 // Endpoint: https://developers.servers.com/api-documentation/v1/#operation/RetrieveAnExistingSubnetwork
 func (h *NetworkPoolsHandler) GetSubnetwork(ctx context.Context, networkPoolID, subnetworkID string) (*Subnetwork, error) {
+	ctx = withOperation(ctx, "NetworkPools.GetSubnetwork", subnetworkPath, networkPoolID, subnetworkID)
 	url := h.client.buildURL(subnetworkPath, []interface{}{networkPoolID, subnetworkID}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -138,6 +144,8 @@ func (h *NetworkPoolsHandler) UpdateSubnetwork(ctx context.Context, networkPoolI
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "NetworkPools.UpdateSubnetwork", subnetworkPath, networkPoolID, subnetworkID)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(subnetworkPath, []interface{}{networkPoolID, subnetworkID}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -157,6 +165,7 @@ func (h *NetworkPoolsHandler) UpdateSubnetwork(ctx context.Context, networkPoolI
 // DeleteSubnetwork delete subnetwork
 // Endpoint: https://developers.servers.com/api-documentation/v1/#operation/DeleteAnExistingSubnetwork
 func (h *NetworkPoolsHandler) DeleteSubnetwork(ctx context.Context, networkPoolID, subnetworkID string) error {
+	ctx = withOperation(ctx, "NetworkPools.DeleteSubnetwork", subnetworkPath, networkPoolID, subnetworkID)
 	url := h.client.buildURL(subnetworkPath, []interface{}{networkPoolID, subnetworkID}...)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -168,5 +177,5 @@ func (h *NetworkPoolsHandler) DeleteSubnetwork(ctx context.Context, networkPoolI
 func (h *NetworkPoolsHandler) Subnetworks(networkPoolID string) Collection[Subnetwork] {
 	path := h.client.buildPath(subnetworkListPath, []interface{}{networkPoolID}...)
 
-	return NewCollection[Subnetwork](h.client, path)
+	return NewCollection[Subnetwork](h.client, path).withOperation("NetworkPools.Subnetworks", subnetworkListPath, networkPoolID)
 }
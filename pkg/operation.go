@@ -0,0 +1,48 @@
+package serverscom
+
+import (
+	"context"
+	"fmt"
+)
+
+type operationContextKey struct{}
+
+// operation describes a service method call, handlers attach it to the context
+// so the request executor knows what is called apart from the expanded URL.
+type operation struct {
+	// Name is a service and method name, e.g. Hosts.PowerCycleDedicatedServer
+	Name string
+
+	// Path is a path template, e.g. /hosts/dedicated_servers/%s/power_cycle
+	Path string
+
+	// ResourceID is the last value used to expand the path template
+	ResourceID string
+}
+
+func newOperation(name, path string, values ...interface{}) operation {
+	op := operation{
+		Name: name,
+		Path: path,
+	}
+
+	if len(values) > 0 {
+		op.ResourceID = fmt.Sprint(values[len(values)-1])
+	}
+
+	return op
+}
+
+func withOperation(ctx context.Context, name, path string, values ...interface{}) context.Context {
+	return contextWithOperation(ctx, newOperation(name, path, values...))
+}
+
+func contextWithOperation(ctx context.Context, op operation) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, op)
+}
+
+func operationFromContext(ctx context.Context) (operation, bool) {
+	op, ok := ctx.Value(operationContextKey{}).(operation)
+
+	return op, ok
+}
@@ -0,0 +1,162 @@
+package serverscom
+
+import (
+	"crypto/tls"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const defaultUserAgent = "go-serverscom-client"
+
+// Option configures a client built by NewClientWithOptions
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	baseURL   string
+	userAgent string
+
+	httpClient *http.Client
+	transport  http.RoundTripper
+	tlsConfig  *tls.Config
+	timeout    time.Duration
+
+	middlewares     []Middleware
+	tracerProvider  trace.TracerProvider
+	propagator      propagation.TextMapPropagator
+	metrics         MetricsRecorder
+	logger          *slog.Logger
+	logLevels       *LogLevels
+	dryRunPlan      *Plan
+	cache           Cache
+	cacheTTLs       map[string]time.Duration
+	circuitBreaker  *CircuitBreakerSettings
+	inputValidation bool
+	tokenSource     TokenSource
+	retryPolicy     *RetryPolicy
+	rateLimit       *RateLimit
+	readRateLimit   *RateLimit
+	writeRateLimit  *RateLimit
+}
+
+// WithBaseURL sets api endpoint, by default: https://api.servers.com/v1
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithUserAgent sets custom User-Agent header, by default: go-serverscom-client
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithHTTPClient sets http.Client used to perform requests.
+//
+// The provided client is copied, so options such WithTimeout and WithTransport don't modify it.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTransport sets http.RoundTripper used to perform requests
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *clientOptions) {
+		o.transport = transport
+	}
+}
+
+// WithTLSConfig sets TLS configuration, it's useful for custom CA bundles and client certificates.
+//
+// This option is applied only when the transport is an *http.Transport.
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(o *clientOptions) {
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// WithTimeout sets a time limit for each request made by the client
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithMiddleware appends middlewares to the client chain, see Use
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(o *clientOptions) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+// WithTokenSource sets a token source, see SetTokenSource
+func WithTokenSource(source TokenSource) Option {
+	return func(o *clientOptions) {
+		o.tokenSource = source
+	}
+}
+
+// WithRetryPolicy sets retry policy, see SetRetryPolicy
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *clientOptions) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithRateLimit sets a budget shared by all requests, see SetRateLimit
+func WithRateLimit(limit *RateLimit) Option {
+	return func(o *clientOptions) {
+		o.rateLimit = limit
+	}
+}
+
+// WithReadRateLimit sets a budget for read requests, see SetReadRateLimit
+func WithReadRateLimit(limit *RateLimit) Option {
+	return func(o *clientOptions) {
+		o.readRateLimit = limit
+	}
+}
+
+// WithWriteRateLimit sets a budget for write requests, see SetWriteRateLimit
+func WithWriteRateLimit(limit *RateLimit) Option {
+	return func(o *clientOptions) {
+		o.writeRateLimit = limit
+	}
+}
+
+func (o *clientOptions) buildHTTPClient() *http.Client {
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		*httpClient = *o.httpClient
+	}
+
+	if o.transport != nil {
+		httpClient.Transport = o.transport
+	}
+
+	if httpClient.Transport == nil {
+		httpClient.Transport = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		}
+	}
+
+	if o.tlsConfig != nil {
+		if tr, ok := httpClient.Transport.(*http.Transport); ok {
+			tr = tr.Clone()
+			tr.TLSClientConfig = o.tlsConfig
+			httpClient.Transport = tr
+		}
+	}
+
+	if o.timeout > 0 {
+		httpClient.Timeout = o.timeout
+	}
+
+	return httpClient
+}
@@ -0,0 +1,117 @@
+package serverscom
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestNewClientWithOptionsDefaults(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := NewClientWithOptions("token")
+
+	g.Expect(client.baseURL).To(Equal(defaultAPIEndpoint))
+	g.Expect(client.UserAgent).To(BeEmpty())
+	g.Expect(client.client.Header.Get("User-Agent")).To(Equal(defaultUserAgent))
+	g.Expect(client.retryPolicy).To(BeNil())
+}
+
+func TestNewClientWithOptionsUserAgentAndTransport(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var captured *http.Request
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		captured = r
+
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/"+sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		BuildWithOptions(
+			WithUserAgent("my-tool/1.0"),
+			WithTransport(transport),
+		)
+
+	defer ts.Close()
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(client.UserAgent).To(Equal("my-tool/1.0"))
+	g.Expect(captured).NotTo(BeNil())
+	g.Expect(captured.Header.Get("User-Agent")).To(Equal("my-tool/1.0"))
+	g.Expect(captured.Header.Get("Authorization")).To(Equal("Bearer testing_token"))
+}
+
+func TestNewClientWithOptionsHTTPClient(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	transport := &http.Transport{}
+	httpClient := &http.Client{Transport: transport}
+
+	options := &clientOptions{}
+
+	WithHTTPClient(httpClient)(options)
+	WithTimeout(5 * time.Second)(options)
+	WithTLSConfig(&tls.Config{ServerName: "api.example.com"})(options)
+
+	result := options.buildHTTPClient()
+
+	g.Expect(result).NotTo(BeIdenticalTo(httpClient))
+	g.Expect(result.Timeout).To(Equal(5 * time.Second))
+	g.Expect(result.Transport.(*http.Transport).TLSClientConfig.ServerName).To(Equal("api.example.com"))
+
+	g.Expect(httpClient.Timeout).To(BeZero())
+	g.Expect(httpClient.Transport).To(BeIdenticalTo(transport))
+}
+
+func TestNewClientWithOptionsDefaultTransport(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	options := &clientOptions{}
+
+	WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12})(options)
+
+	result := options.buildHTTPClient()
+
+	tr, ok := result.Transport.(*http.Transport)
+
+	g.Expect(ok).To(BeTrue())
+	g.Expect(tr.Proxy).NotTo(BeNil())
+	g.Expect(tr.TLSClientConfig.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+}
+
+func TestNewClientWithOptionsRetryAndRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	policy := DefaultRetryPolicy()
+
+	client := NewClientWithOptions(
+		"token",
+		WithBaseURL("https://api.example.com/v1"),
+		WithRetryPolicy(policy),
+		WithRateLimit(&RateLimit{RequestsPerSecond: 10}),
+		WithWriteRateLimit(&RateLimit{RequestsPerSecond: 1}),
+	)
+
+	g.Expect(client.baseURL).To(Equal("https://api.example.com/v1"))
+	g.Expect(client.retryPolicy).To(BeIdenticalTo(policy))
+	g.Expect(client.rateLimiter.all.Burst()).To(Equal(10))
+	g.Expect(client.rateLimiter.read).To(BeNil())
+	g.Expect(client.rateLimiter.write.Burst()).To(Equal(1))
+}
@@ -29,12 +29,13 @@ type RacksHandler struct {
 // Collection builds a new Collection[Rack] interface
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Rack/operation/ListRacks
 func (h *RacksHandler) Collection() Collection[Rack] {
-	return NewCollection[Rack](h.client, rackPath)
+	return NewCollection[Rack](h.client, rackPath).withOperation("Racks.Collection", rackPath)
 }
 
 // GetRack returns a rack
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Rack/operation/GetARack
 func (h *RacksHandler) Get(ctx context.Context, id string) (*Rack, error) {
+	ctx = withOperation(ctx, "Racks.Get", rackPathWithID, id)
 	url := h.client.buildURL(rackPathWithID, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -61,6 +62,8 @@ func (h *RacksHandler) Update(ctx context.Context, id string, input RackUpdateIn
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "Racks.Update", rackPathWithID, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(rackPathWithID, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
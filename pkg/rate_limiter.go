@@ -0,0 +1,132 @@
+package serverscom
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit describes a token bucket budget for requests
+type RateLimit struct {
+	// RequestsPerSecond is a rate at which the bucket is refilled
+	RequestsPerSecond float64
+
+	// Burst is a size of the bucket, when it's not set the rounded up RequestsPerSecond is used
+	Burst int
+}
+
+// rateLimiter holds token buckets shared by all services and collections of a client
+type rateLimiter struct {
+	all   *rate.Limiter
+	read  *rate.Limiter
+	write *rate.Limiter
+}
+
+// SetRateLimit sets a budget shared by all requests made by the client, nil disables it
+func (cli *Client) SetRateLimit(limit *RateLimit) {
+	cli.rateLimiter.all = newLimiter(limit)
+}
+
+// SetReadRateLimit sets a separate budget for GET requests made by the client, nil disables it.
+//
+// This budget is applied in addition to the one set by SetRateLimit.
+func (cli *Client) SetReadRateLimit(limit *RateLimit) {
+	cli.rateLimiter.read = newLimiter(limit)
+}
+
+// SetWriteRateLimit sets a separate budget for POST, PUT and DELETE requests made by the client, nil disables it.
+//
+// This budget is applied in addition to the one set by SetRateLimit.
+func (cli *Client) SetWriteRateLimit(limit *RateLimit) {
+	cli.rateLimiter.write = newLimiter(limit)
+}
+
+// wait blocks until all budgets related to the method allow a request or the context is done,
+// it returns the time spent waiting, which is zero when no budget is set.
+//
+// Tokens are reserved in all budgets at once and given back when the request can't be sent,
+// so cancelled requests don't consume the burst. A wait which would exceed the context deadline
+// fails immediately with an error wrapping context.DeadlineExceeded.
+func (l *rateLimiter) wait(ctx context.Context, method string) (time.Duration, error) {
+	limiters := []*rate.Limiter{l.all, l.write}
+
+	if isReadMethod(method) {
+		limiters[1] = l.read
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("Client rate limit error: %w", err)
+	}
+
+	now := time.Now()
+
+	var (
+		reservations []*rate.Reservation
+		delay        time.Duration
+	)
+
+	cancel := func() {
+		for _, reservation := range reservations {
+			reservation.CancelAt(now)
+		}
+	}
+
+	for _, limiter := range limiters {
+		if limiter == nil {
+			continue
+		}
+
+		reservation := limiter.ReserveN(now, 1)
+		if !reservation.OK() {
+			cancel()
+
+			return 0, fmt.Errorf("Client rate limit error: burst %d is too small", limiter.Burst())
+		}
+
+		reservations = append(reservations, reservation)
+		delay = max(delay, reservation.DelayFrom(now))
+	}
+
+	if delay == 0 {
+		return 0, nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && now.Add(delay).After(deadline) {
+		cancel()
+
+		return 0, fmt.Errorf("Client rate limit error: %w: waiting %s would exceed context deadline", context.DeadlineExceeded, delay)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		cancel()
+
+		return time.Since(now), fmt.Errorf("Client rate limit error: %w", ctx.Err())
+	}
+}
+
+func newLimiter(limit *RateLimit) *rate.Limiter {
+	if limit == nil || limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = int(math.Ceil(limit.RequestsPerSecond))
+	}
+
+	return rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+}
+
+func isReadMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
@@ -0,0 +1,164 @@
+package serverscom
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRateLimitDelaysRequests(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=2&per_page=1>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "a"}]`).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=3&per_page=1>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "b"}]`).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`[{"id": "c"}]`).
+		Build()
+
+	defer ts.Close()
+
+	client.SetRateLimit(&RateLimit{RequestsPerSecond: 20, Burst: 1})
+
+	ctx := context.TODO()
+
+	startedAt := time.Now()
+
+	list, err := client.Hosts.Collection().Collect(ctx)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(len(list)).To(Equal(3))
+	g.Expect(time.Since(startedAt)).To(BeNumerically(">=", 90*time.Millisecond))
+}
+
+func TestRateLimitRespectsContext(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		Build()
+
+	defer ts.Close()
+
+	client.SetRateLimit(&RateLimit{RequestsPerSecond: 0.1, Burst: 1})
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(err).To(BeNil())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	g.Expect(len(ts.Requests)).To(Equal(1))
+
+	_, err = Wait(ctx, func(ctx context.Context) (*SSHKey, error) {
+		return client.SSHKeys.Get(ctx, sshFingerprint)
+	}, WaitCondition[*SSHKey]{
+		Ready: func(*SSHKey) bool { return true },
+	}, nil)
+
+	var timeoutErr *WaitTimeoutError
+
+	g.Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+	g.Expect(timeoutErr.Attempts).To(Equal(1))
+	g.Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	g.Expect(len(ts.Requests)).To(Equal(1))
+}
+
+func TestRateLimitGivesBackTokensOfCancelledRequests(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		Build()
+
+	defer ts.Close()
+
+	client.SetRateLimit(&RateLimit{RequestsPerSecond: 0.01, Burst: 2})
+	client.SetReadRateLimit(&RateLimit{RequestsPerSecond: 0.01, Burst: 1})
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(err).To(BeNil())
+
+	deadlineCtx, cancelDeadline := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelDeadline()
+
+	_, err = client.SSHKeys.Get(deadlineCtx, sshFingerprint)
+	g.Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	_, err = client.SSHKeys.Get(cancelledCtx, sshFingerprint)
+	g.Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+	g.Expect(client.rateLimiter.all.Tokens()).To(BeNumerically("~", 1, 0.01))
+	g.Expect(len(ts.Requests)).To(Equal(0))
+}
+
+func TestRateLimitSeparatesReadAndWrite(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("DELETE").
+		WithResponseCode(204).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("DELETE").
+		WithResponseCode(204).
+		Build()
+
+	defer ts.Close()
+
+	client.SetWriteRateLimit(&RateLimit{RequestsPerSecond: 0.1, Burst: 1})
+
+	ctx := context.TODO()
+
+	g.Expect(client.SSHKeys.Delete(ctx, sshFingerprint)).To(Succeed())
+
+	_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeNil())
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeNil())
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	g.Expect(client.SSHKeys.Delete(timeoutCtx, sshFingerprint)).NotTo(Succeed())
+	g.Expect(len(ts.Requests)).To(Equal(1))
+}
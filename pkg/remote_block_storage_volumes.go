@@ -34,12 +34,13 @@ type RemoteBlockStorageVolumesHandler struct {
 
 // Collection builds a new Collection[RemoteBlockStorageVolume] interface
 func (h *RemoteBlockStorageVolumesHandler) Collection() Collection[RemoteBlockStorageVolume] {
-	return NewCollection[RemoteBlockStorageVolume](h.client, remoteBlockStorageVolumePath)
+	return NewCollection[RemoteBlockStorageVolume](h.client, remoteBlockStorageVolumePath).withOperation("RemoteBlockStorageVolumes.Collection", remoteBlockStorageVolumePath)
 }
 
 // Get a remote block storage volume
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Remote-Block-Storage-Volume/operation/GetAnRbsVolumee
 func (h *RemoteBlockStorageVolumesHandler) Get(ctx context.Context, id string) (*RemoteBlockStorageVolume, error) {
+	ctx = withOperation(ctx, "RemoteBlockStorageVolumes.Get", remoteBlockStorageVolumePathWithID, id)
 	url := h.client.buildURL(remoteBlockStorageVolumePathWithID, id)
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
 	if err != nil {
@@ -59,6 +60,8 @@ func (h *RemoteBlockStorageVolumesHandler) Create(ctx context.Context, input Rem
 	if err != nil {
 		return nil, err
 	}
+	ctx = withOperation(ctx, "RemoteBlockStorageVolumes.Create", remoteBlockStorageVolumePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(remoteBlockStorageVolumePath)
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
 	if err != nil {
@@ -78,6 +81,8 @@ func (h *RemoteBlockStorageVolumesHandler) Update(ctx context.Context, id string
 	if err != nil {
 		return nil, err
 	}
+	ctx = withOperation(ctx, "RemoteBlockStorageVolumes.Update", remoteBlockStorageVolumePathWithID, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(remoteBlockStorageVolumePathWithID, id)
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
 	if err != nil {
@@ -93,6 +98,7 @@ func (h *RemoteBlockStorageVolumesHandler) Update(ctx context.Context, id string
 // Delete a remote block storage volume
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Remote-Block-Storage-Volume/operation/DeleteAnRbsVolume
 func (h *RemoteBlockStorageVolumesHandler) Delete(ctx context.Context, id string) error {
+	ctx = withOperation(ctx, "RemoteBlockStorageVolumes.Delete", remoteBlockStorageVolumePathWithID, id)
 	url := h.client.buildURL(remoteBlockStorageVolumePathWithID, id)
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
 
@@ -102,6 +108,7 @@ func (h *RemoteBlockStorageVolumesHandler) Delete(ctx context.Context, id string
 // Get credentials for a remote block storage volume
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Remote-Block-Storage-Volume/operation/GetCredentialsForAnRbsVolume
 func (h *RemoteBlockStorageVolumesHandler) GetCredentials(ctx context.Context, id string) (*RemoteBlockStorageVolumeCredentials, error) {
+	ctx = withOperation(ctx, "RemoteBlockStorageVolumes.GetCredentials", remoteBlockStorageVolumePathWithID+actionGetCredentials, id)
 	url := h.client.buildURL(remoteBlockStorageVolumePathWithID+actionGetCredentials, id)
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
 	if err != nil {
@@ -117,6 +124,7 @@ func (h *RemoteBlockStorageVolumesHandler) GetCredentials(ctx context.Context, i
 // Reset credentials for a remote block storage volume
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/Remote-Block-Storage-Volume/operation/ResetCredentialsForAnRbsVolume
 func (h *RemoteBlockStorageVolumesHandler) ResetCredentials(ctx context.Context, id string) (*RemoteBlockStorageVolume, error) {
+	ctx = withOperation(ctx, "RemoteBlockStorageVolumes.ResetCredentials", remoteBlockStorageVolumePathWithID+actionResetCredentials, id)
 	url := h.client.buildURL(remoteBlockStorageVolumePathWithID+actionResetCredentials, id)
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, nil)
 	if err != nil {
@@ -0,0 +1,111 @@
+package serverscom
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	requestIDHeader          = "X-Request-Id"
+	rateLimitLimitHeader     = "X-RateLimit-Limit"
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+// RateLimitInfo represents rate limit headers of an API response, fields are zero when headers are missing
+type RateLimitInfo struct {
+	// Limit is a number of requests allowed in the current window
+	Limit int
+
+	// Remaining is a number of requests left in the current window
+	Remaining int
+
+	// Reset is a time when the current window ends
+	Reset time.Time
+}
+
+// RequestID returns an identifier of the request assigned by the API, it's useful for support tickets
+func (r *Response) RequestID() string {
+	return r.Header.Get(requestIDHeader)
+}
+
+// RateLimit returns rate limit info of the response
+func (r *Response) RateLimit() RateLimitInfo {
+	var info RateLimitInfo
+
+	info.Limit, _ = strconv.Atoi(r.Header.Get(rateLimitLimitHeader))
+	info.Remaining, _ = strconv.Atoi(r.Header.Get(rateLimitRemainingHeader))
+
+	if reset, err := strconv.ParseInt(r.Header.Get(rateLimitResetHeader), 10, 64); err == nil {
+		info.Reset = time.Unix(reset, 0)
+	}
+
+	return info
+}
+
+// Links returns pagination links of the response by rel, e.g. next, prev, first and last
+func (r *Response) Links() map[string]string {
+	return hyperHeaderParser(r.Header)
+}
+
+// ResponseCollector collects responses of API calls made with a context returned by WithResponseCollector.
+//
+//	ctx, responses := serverscom.WithResponseCollector(ctx)
+//	server, err := client.Hosts.GetDedicatedServer(ctx, id)
+//	log.Println(responses.Last().RequestID())
+//
+// A response is collected per call after all retries, including calls which returned a typed error.
+// Collect and other collection methods add a response per fetched page.
+type ResponseCollector struct {
+	mu        sync.Mutex
+	responses []*Response
+}
+
+type responseCollectorContextKey struct{}
+
+// WithResponseCollector returns a context which collects responses of API calls made with it
+func WithResponseCollector(ctx context.Context) (context.Context, *ResponseCollector) {
+	collector := &ResponseCollector{}
+
+	return context.WithValue(ctx, responseCollectorContextKey{}, collector), collector
+}
+
+// All returns collected responses in the order they were received
+func (c *ResponseCollector) All() []*Response {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]*Response(nil), c.responses...)
+}
+
+// Last returns the last collected response, or nil if there is none
+func (c *ResponseCollector) Last() *Response {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.responses) == 0 {
+		return nil
+	}
+
+	return c.responses[len(c.responses)-1]
+}
+
+func (c *ResponseCollector) add(resp *Response) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.responses = append(c.responses, resp)
+}
+
+// collectResponse adds the response to a collector attached to the context, if any
+func collectResponse(ctx context.Context, resp *Response) {
+	if resp == nil {
+		return
+	}
+
+	if collector, ok := ctx.Value(responseCollectorContextKey{}).(*ResponseCollector); ok {
+		collector.add(resp)
+	}
+}
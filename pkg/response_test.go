@@ -0,0 +1,94 @@
+package serverscom
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestResponseCollectorCapturesMetadata(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers/" + serverID).
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"X-Request-Id":          "req-1",
+			"X-RateLimit-Limit":     "100",
+			"X-RateLimit-Remaining": "99",
+			"X-RateLimit-Reset":     "1700000000",
+		}).
+		WithResponseBodyStubFile("fixtures/hosts/dedicated_servers/get_response.json").
+		Build()
+
+	defer ts.Close()
+
+	ctx, responses := WithResponseCollector(context.TODO())
+
+	server, err := client.Hosts.GetDedicatedServer(ctx, serverID)
+	g.Expect(err).To(BeNil())
+	g.Expect(server).NotTo(BeNil())
+
+	resp := responses.Last()
+	g.Expect(resp).NotTo(BeNil())
+	g.Expect(resp.StatusCode).To(Equal(200))
+	g.Expect(resp.RequestID()).To(Equal("req-1"))
+	g.Expect(resp.RateLimit()).To(Equal(RateLimitInfo{
+		Limit:     100,
+		Remaining: 99,
+		Reset:     time.Unix(1700000000, 0),
+	}))
+}
+
+func TestResponseCollectorCapturesPagesAndErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts?page=2&per_page=1>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "a"}]`).
+		Next().
+		WithRequestPath("/hosts").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`[{"id": "b"}]`).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{"X-Request-Id": "req-404"}).
+		WithResponseBodyStubInline(`{"code": "NOT_FOUND", "message": "SSH key not found"}`).
+		WithResponseCode(404).
+		Build()
+
+	defer ts.Close()
+
+	ctx, responses := WithResponseCollector(context.TODO())
+
+	list, err := client.Hosts.Collection().Collect(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(list).To(HaveLen(2))
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeAssignableToTypeOf(&NotFoundError{}))
+	g.Expect(err.(*NotFoundError).RequestID).To(Equal("req-404"))
+
+	all := responses.All()
+	g.Expect(all).To(HaveLen(3))
+	g.Expect(all[0].Links()).To(Equal(map[string]string{"next": "https://dummy.api.com/hosts?page=2&per_page=1"}))
+	g.Expect(all[1].Links()).To(BeEmpty())
+	g.Expect(all[2].StatusCode).To(Equal(404))
+	g.Expect(all[2].RequestID()).To(Equal("req-404"))
+}
+
+func TestResponseCollectorEmpty(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, responses := WithResponseCollector(context.TODO())
+
+	g.Expect(responses.Last()).To(BeNil())
+	g.Expect(responses.All()).To(BeEmpty())
+}
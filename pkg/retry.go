@@ -0,0 +1,182 @@
+package serverscom
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 4
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy describes how failed requests are retried by the client.
+//
+// A request is retried when the API responds with one of StatusCodes or when the request
+// fails with a transient transport error, e.g. a timeout or a reset connection, as long as the
+// request method is listed in Methods. Other transport errors, like certificate errors, are
+// returned at once. Delays between attempts grow exponentially from MinBackoff up to MaxBackoff
+// with a random jitter, a Retry-After header sent by the API takes precedence over the computed
+// delay, but is capped by MaxBackoff as well.
+// Retries stop as soon as the context is done or its deadline would be exceeded by the next delay.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one, values below 2 disable retries
+	MaxAttempts int
+
+	// MinBackoff is the base delay before the first retry
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts, including delays of Retry-After headers
+	MaxBackoff time.Duration
+
+	// Methods lists HTTP methods which are allowed to be retried, POST has to be added explicitly
+	Methods []string
+
+	// StatusCodes lists response status codes which trigger a retry
+	StatusCodes []int
+}
+
+// DefaultRetryPolicy returns a policy which retries GET and DELETE requests up to 4 attempts
+// on 429, 502, 503, 504 response status codes and on transport errors.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+		Methods:     []string{http.MethodGet, http.MethodDelete},
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// SetRetryPolicy sets retry policy for client, nil disables retries
+func (cli *Client) SetRetryPolicy(policy *RetryPolicy) {
+	cli.retryPolicy = policy
+}
+
+// nextDelay returns a delay before the next attempt and true when the request should be retried.
+func (p *RetryPolicy) nextDelay(ctx context.Context, method string, attempt int, resp *Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	if !slices.Contains(p.Methods, method) {
+		return 0, false
+	}
+
+	if ctx.Err() != nil {
+		return 0, false
+	}
+
+	var delay time.Duration
+
+	switch {
+	case err != nil:
+		if !isTransientError(err) {
+			return 0, false
+		}
+
+		delay = p.backoff(attempt)
+	case resp != nil && slices.Contains(p.StatusCodes, resp.StatusCode):
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			delay = retryAfter
+
+			if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+				delay = p.MaxBackoff
+			}
+		} else {
+			delay = p.backoff(attempt)
+		}
+	default:
+		return 0, false
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+		return 0, false
+	}
+
+	return delay, true
+}
+
+// backoff returns an exponential delay for the attempt with a jitter in range [delay/2, delay].
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.MinBackoff
+
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// retryAfter returns a delay from Retry-After header of the response, or zero when it's missing
+func retryAfter(resp *Response) time.Duration {
+	delay, _ := parseRetryAfter(resp.Header.Get("Retry-After"))
+
+	return delay
+}
+
+// parseRetryAfter parses Retry-After header value in both delay-seconds and HTTP-date formats.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	delay := time.Until(date)
+	if delay < 0 {
+		delay = 0
+	}
+
+	return delay, true
+}
+
+// sleepContext waits for the delay or until the context is done.
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
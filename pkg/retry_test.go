@@ -0,0 +1,274 @@
+package serverscom
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	return policy
+}
+
+func TestRetryPolicyRetriesGetRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "UNAVAILABLE", "message": "Maintenance"}`).
+		WithResponseCode(503).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{"Retry-After": "0"}).
+		WithResponseBodyStubInline(`{"code": "TOO_MANY_REQUESTS", "message": "Slow down"}`).
+		WithResponseCode(429).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	ctx := context.TODO()
+
+	SSHKey, err := client.SSHKeys.Get(ctx, sshFingerprint)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(SSHKey).ToNot(BeNil())
+	g.Expect(SSHKey.Fingerprint).To(Equal(sshFingerprint))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestRetryPolicyStopsAfterMaxAttempts(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("DELETE").
+		WithResponseBodyStubInline(`{"code": "BAD_GATEWAY", "message": "Bad gateway"}`).
+		WithResponseCode(502).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("DELETE").
+		WithResponseBodyStubInline(`{"code": "BAD_GATEWAY", "message": "Bad gateway"}`).
+		WithResponseCode(502).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("DELETE").
+		WithResponseCode(204).
+		Build()
+
+	defer ts.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 2
+	client.SetRetryPolicy(policy)
+
+	ctx := context.TODO()
+
+	err := client.SSHKeys.Delete(ctx, sshFingerprint)
+
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(len(ts.Requests)).To(Equal(1))
+}
+
+func TestRetryPolicySkipsPostByDefault(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys").
+		WithRequestMethod("POST").
+		WithResponseBodyStubInline(`{"code": "UNAVAILABLE", "message": "Maintenance"}`).
+		WithResponseCode(503).
+		Next().
+		WithRequestPath("/ssh_keys").
+		WithRequestMethod("POST").
+		WithResponseBodyStubFile("fixtures/ssh_keys/create_response.json").
+		WithResponseCode(201).
+		Build()
+
+	defer ts.Close()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	ctx := context.TODO()
+
+	SSHKey, err := client.SSHKeys.Create(ctx, SSHKeyCreateInput{Name: "test-key", PublicKey: sshPublicKey})
+
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(SSHKey).To(BeNil())
+	g.Expect(len(ts.Requests)).To(Equal(1))
+}
+
+func TestRetryPolicyRetriesPostWhenAllowed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys").
+		WithRequestMethod("POST").
+		WithResponseBodyStubInline(`{"code": "UNAVAILABLE", "message": "Maintenance"}`).
+		WithResponseCode(503).
+		Next().
+		WithRequestPath("/ssh_keys").
+		WithRequestMethod("POST").
+		WithResponseBodyStubFile("fixtures/ssh_keys/create_response.json").
+		WithResponseCode(201).
+		Build()
+
+	defer ts.Close()
+
+	policy := testRetryPolicy()
+	policy.Methods = append(policy.Methods, http.MethodPost)
+	client.SetRetryPolicy(policy)
+
+	ctx := context.TODO()
+
+	SSHKey, err := client.SSHKeys.Create(ctx, SSHKeyCreateInput{Name: "test-key", PublicKey: sshPublicKey})
+
+	g.Expect(err).To(BeNil())
+	g.Expect(SSHKey).ToNot(BeNil())
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestRetryPolicyRespectsContextDeadline(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{"Retry-After": "60"}).
+		WithResponseBodyStubInline(`{"code": "TOO_MANY_REQUESTS", "message": "Slow down"}`).
+		WithResponseCode(429).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxBackoff = time.Minute
+	client.SetRetryPolicy(policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	startedAt := time.Now()
+
+	_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(time.Since(startedAt)).To(BeNumerically("<", time.Second))
+	g.Expect(len(ts.Requests)).To(Equal(1))
+}
+
+func TestRetryPolicyCapsRetryAfter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{"Retry-After": "3600"}).
+		WithResponseBodyStubInline(`{"code": "TOO_MANY_REQUESTS", "message": "Slow down"}`).
+		WithResponseCode(429).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	startedAt := time.Now()
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(time.Since(startedAt)).To(BeNumerically("<", time.Second))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestRetryPolicyTransportErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var transportErr error
+
+	calls := 0
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+
+		return nil, transportErr
+	})
+
+	client := NewClientWithOptions("token", WithTransport(transport))
+	client.SetRetryPolicy(testRetryPolicy())
+
+	transportErr = &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(errors.Is(err, syscall.ECONNREFUSED)).To(BeTrue())
+	g.Expect(calls).To(Equal(defaultRetryMaxAttempts))
+
+	calls = 0
+	transportErr = &tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}
+
+	_, err = client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(calls).To(Equal(1))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	policy := &RetryPolicy{
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: time.Second,
+	}
+
+	g.Expect(policy.backoff(1)).To(BeNumerically("~", 75*time.Millisecond, 25*time.Millisecond))
+	g.Expect(policy.backoff(2)).To(BeNumerically("~", 150*time.Millisecond, 50*time.Millisecond))
+	g.Expect(policy.backoff(3)).To(BeNumerically("~", 300*time.Millisecond, 100*time.Millisecond))
+	g.Expect(policy.backoff(10)).To(BeNumerically("~", 750*time.Millisecond, 250*time.Millisecond))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	delay, ok := parseRetryAfter("120")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(delay).To(Equal(120 * time.Second))
+
+	delay, ok = parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(delay).To(BeNumerically("~", time.Hour, 2*time.Second))
+
+	_, ok = parseRetryAfter("")
+	g.Expect(ok).To(BeFalse())
+
+	_, ok = parseRetryAfter("soon")
+	g.Expect(ok).To(BeFalse())
+}
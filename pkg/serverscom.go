@@ -7,8 +7,11 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const defaultAPIEndpoint string = "https://api.servers.com/v1"
@@ -47,6 +50,18 @@ type Client struct {
 	RemoteBlockStorageVolumes RemoteBlockStorageVolumesService
 
 	client *resty.Client
+
+	tokenSource     TokenSource
+	retryPolicy     *RetryPolicy
+	rateLimiter     rateLimiter
+	middlewares     []Middleware
+	tracing         tracing
+	metrics         MetricsRecorder
+	logging         logging
+	dryRunPlan      *Plan
+	cache           httpCache
+	circuitBreaker  *circuitBreaker
+	inputValidation bool
 }
 
 // NewClient builds a new client with token
@@ -56,21 +71,52 @@ func NewClient(token string) *Client {
 
 // NewClientWithEndpoint builds a new client with token and api endpoint
 func NewClientWithEndpoint(token, baseURL string) *Client {
-	tr := &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
+	return NewClientWithOptions(token, WithBaseURL(baseURL))
+}
+
+// NewClientWithOptions builds a new client with token and options
+func NewClientWithOptions(token string, opts ...Option) *Client {
+	options := &clientOptions{
+		baseURL: defaultAPIEndpoint,
+	}
+
+	for _, opt := range opts {
+		opt(options)
 	}
 
-	rClient := resty.NewWithClient(&http.Client{Transport: tr})
+	rClient := resty.NewWithClient(options.buildHTTPClient())
 
-	rClient.SetAuthToken(token)
 	rClient.SetHeader("Content-Type", "application/json")
-	rClient.SetHeader("User-Agent", "go-serverscom-client")
+	rClient.SetHeader("User-Agent", defaultUserAgent)
 
 	scClient := &Client{
-		baseURL: baseURL,
-		client:  rClient,
+		baseURL:         options.baseURL,
+		client:          rClient,
+		tokenSource:     options.tokenSource,
+		retryPolicy:     options.retryPolicy,
+		tracing:         newTracing(options.tracerProvider, options.propagator),
+		metrics:         options.metrics,
+		logging:         newLogging(options.logger, options.logLevels),
+		dryRunPlan:      options.dryRunPlan,
+		cache:           httpCache{cache: options.cache, ttls: options.cacheTTLs},
+		circuitBreaker:  newCircuitBreaker(options.circuitBreaker),
+		inputValidation: options.inputValidation,
+	}
+
+	if scClient.metrics == nil {
+		scClient.metrics = noopMetricsRecorder{}
+	}
+
+	if scClient.tokenSource == nil && token != "" {
+		scClient.tokenSource = StaticTokenSource(token)
 	}
 
+	scClient.Use(options.middlewares...)
+	scClient.SetupUserAgent(options.userAgent)
+	scClient.SetRateLimit(options.rateLimit)
+	scClient.SetReadRateLimit(options.readRateLimit)
+	scClient.SetWriteRateLimit(options.writeRateLimit)
+
 	scClient.configureResources()
 
 	return scClient
@@ -85,6 +131,9 @@ func (cli *Client) SetupUserAgent(userAgent string) {
 }
 
 // SetVerbose sets debug mode for client
+//
+// Deprecated: the debug output of the underlying HTTP client contains the Authorization header
+// and secrets from responses, use WithLogger instead.
 func (cli *Client) SetVerbose(verbose bool) {
 	cli.client.SetDebug(verbose)
 }
@@ -139,33 +188,169 @@ func (cli *Client) applyParams(endpointURL string, params map[string]string) str
 	)
 }
 
-func (cli *Client) buildAndExecRequestWithResponse(ctx context.Context, method, endpointURL string, body []byte) (*resty.Response, []byte, error) {
-	request := cli.client.R().SetContext(ctx)
+func (cli *Client) buildAndExecRequestWithResponse(ctx context.Context, method, endpointURL string, body []byte) (*Response, []byte, error) {
+	if err := cli.validateInput(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	if cli.planRequest(ctx, method, endpointURL, body) {
+		return nil, nil, ErrDryRun
+	}
+
+	startedAt := time.Now()
+
+	ctx, span := cli.tracing.startSpan(ctx, method, endpointURL)
+
+	raw, cacheHit, err := cli.execWithCache(ctx, method, endpointURL, body)
+
+	var (
+		resp     *Response
+		contents []byte
+	)
+
+	if err == nil {
+		resp, contents, err = cli.handleResponse(ctx, raw)
+	}
+
+	collectResponse(ctx, raw)
+
+	if cacheHit {
+		span.SetAttributes(cacheAttributeKey.String("hit"))
+	} else {
+		cli.observeRequest(ctx, method, raw, err, time.Since(startedAt))
+	}
+
+	endSpan(span, raw, err)
+
+	return resp, contents, err
+}
+
+func (cli *Client) observeRequest(ctx context.Context, method string, resp *Response, err error, duration time.Duration) {
+	op, _ := operationFromContext(ctx)
+
+	var (
+		statusCode int
+		errType    string
+	)
+
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+
+	if err != nil {
+		errType = errorType(err)
+	}
+
+	cli.metrics.ObserveRequest(method, op.Path, statusCode, errType, duration)
+}
+
+func (cli *Client) execWithRetries(ctx context.Context, method, endpointURL string, body []byte, header http.Header) (*Response, error) {
+	handler := cli.handler()
+	op, _ := operationFromContext(ctx)
+
+	var (
+		resp           *Response
+		err            error
+		tokenRefreshed bool
+	)
+
+	for attempt := 1; ; attempt++ {
+		waited, waitErr := cli.rateLimiter.wait(ctx, method)
+		if waited > 0 {
+			cli.metrics.ObserveRateLimitWait(method, op.Path, waited)
+		}
+
+		if waitErr != nil {
+			return nil, waitErr
+		}
+
+		token, tokenErr := cli.token()
+		if tokenErr != nil {
+			return nil, tokenErr
+		}
+
+		probe, breakerErr := cli.circuitBreaker.allow()
+		if breakerErr != nil {
+			return nil, breakerErr
+		}
+
+		req := newRequest(method, endpointURL, token, body, header)
+		cli.tracing.inject(ctx, req.Header)
+		cli.logging.logRequest(ctx, req, attempt)
+
+		sentAt := time.Now()
+		resp, err = handler(ctx, req)
+		cli.logging.logResponse(ctx, req, resp, err, time.Since(sentAt))
+		cli.circuitBreaker.record(ctx, probe, resp, err)
+
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && !tokenRefreshed {
+			tokenRefreshed = true
 
-	if body != nil {
-		request.SetBody(body)
+			if cli.refreshToken(token) {
+				attempt--
+				continue
+			}
+		}
+
+		delay, retry := cli.retryPolicy.nextDelay(ctx, method, attempt, resp, err)
+		if !retry {
+			break
+		}
+
+		cli.metrics.ObserveRetry(method, op.Path)
+
+		trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+			attribute.Int("attempt", attempt),
+			attribute.String("delay", delay.String()),
+		))
+
+		if sleepContext(ctx, delay) != nil {
+			break
+		}
 	}
 
-	resp, err := request.Execute(method, endpointURL)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Client request error: %q", err)
+		return nil, fmt.Errorf("Client request error: %w", err)
 	}
 
-	contents := resp.Body()
+	return resp, nil
+}
+
+func newRequest(method, endpointURL, token string, body []byte, extraHeader http.Header) *Request {
+	header := extraHeader.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+
+	return &Request{
+		Method: method,
+		URL:    endpointURL,
+		Header: header,
+		Body:   body,
+	}
+}
+
+func (cli *Client) handleResponse(ctx context.Context, resp *Response) (*Response, []byte, error) {
+	contents := resp.Body
 
-	if resp.StatusCode() < 400 {
+	if resp.StatusCode < 400 {
 		return resp, contents, nil
 	}
 
-	contentType := resp.Header().Get("Content-Type")
+	contentType := resp.Header.Get("Content-Type")
 	var responseError responseErrorWrapper
 
 	if strings.HasPrefix(contentType, "application/json") {
 		if err := json.Unmarshal(contents, &responseError); err != nil {
 			return nil, nil, newParsingError(
-				resp.StatusCode(),
+				resp.StatusCode,
 				string(contents),
 				err,
+				resp.RequestID(),
 			)
 		}
 	} else {
@@ -173,23 +358,29 @@ func (cli *Client) buildAndExecRequestWithResponse(ctx context.Context, method,
 		responseError.Message = string(contents)
 	}
 
-	switch resp.StatusCode() {
+	switch resp.StatusCode {
 	case 400:
-		return nil, nil, newBadRequestError(resp.StatusCode(), responseError.Code, responseError.Message)
+		return nil, nil, newBadRequestError(resp.StatusCode, responseError.Code, responseError.Message, resp.RequestID())
 	case 401:
-		return nil, nil, newUnauthorizedError(resp.StatusCode(), responseError.Code, responseError.Message)
+		return nil, nil, newUnauthorizedError(resp.StatusCode, responseError.Code, responseError.Message, resp.RequestID())
 	case 403:
-		return nil, nil, newForbiddenError(resp.StatusCode(), responseError.Code, responseError.Message)
+		return nil, nil, newForbiddenError(resp.StatusCode, responseError.Code, responseError.Message, resp.RequestID())
 	case 404:
-		return nil, nil, newNotFoundError(resp.StatusCode(), responseError.Code, responseError.Message)
+		return nil, nil, newNotFoundError(resp.StatusCode, responseError.Code, responseError.Message, resp.RequestID())
 	case 409:
-		return nil, nil, newConflictError(resp.StatusCode(), responseError.Code, responseError.Message)
+		return nil, nil, newConflictError(resp.StatusCode, responseError.Code, responseError.Message, resp.RequestID())
 	case 422:
-		return nil, nil, newUnprocessableEntityError(resp.StatusCode(), responseError.Code, responseError.Message, responseError.Errors)
+		return nil, nil, newUnprocessableEntityError(resp.StatusCode, responseError.Code, responseError.Message, responseError.Errors, resp.RequestID(), inputFromContext(ctx))
+	case 429:
+		return nil, nil, newTooManyRequestsError(resp.StatusCode, responseError.Code, responseError.Message, resp.RequestID(), retryAfter(resp))
 	case 500:
-		return nil, nil, newInternalServerError(resp.StatusCode(), responseError.Code, responseError.Message)
+		return nil, nil, newInternalServerError(resp.StatusCode, responseError.Code, responseError.Message, resp.RequestID())
+	case 502, 504:
+		return nil, nil, newGatewayError(resp.StatusCode, responseError.Code, responseError.Message, resp.RequestID())
+	case 503:
+		return nil, nil, newServiceUnavailableError(resp.StatusCode, responseError.Code, responseError.Message, resp.RequestID(), retryAfter(resp))
 	default:
-		return nil, nil, fmt.Errorf("Unexpected response code: %d, with body: %s", resp.StatusCode(), string(contents))
+		return nil, nil, newHTTPError(resp.StatusCode, responseError, resp.RequestID(), string(contents))
 	}
 }
 
@@ -0,0 +1,92 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Cassette represents recorded interactions with the API
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction represents a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request" yaml:"request"`
+	Response RecordedResponse `json:"response" yaml:"response"`
+}
+
+// RecordedRequest represents a recorded request with secrets scrubbed
+type RecordedRequest struct {
+	Method string      `json:"method" yaml:"method"`
+	URL    string      `json:"url" yaml:"url"`
+	Header http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body   string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// RecordedResponse represents a recorded response with secrets scrubbed
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code" yaml:"status_code"`
+	Header     http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette from a file, the format is chosen by the extension: .json, .yaml or .yml
+func LoadCassette(path string) (*Cassette, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("recorder: can't read cassette: %w", err)
+	}
+
+	cassette := new(Cassette)
+
+	if isJSON(path) {
+		err = json.Unmarshal(contents, cassette)
+	} else {
+		err = yaml.Unmarshal(contents, cassette)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("recorder: can't parse cassette %s: %w", path, err)
+	}
+
+	return cassette, nil
+}
+
+// Save writes the cassette to a file, the format is chosen by the extension: .json, .yaml or .yml
+func (c *Cassette) Save(path string) error {
+	var (
+		contents []byte
+		err      error
+	)
+
+	if isJSON(path) {
+		contents, err = json.MarshalIndent(c, "", "  ")
+	} else {
+		contents, err = yaml.Marshal(c)
+	}
+
+	if err != nil {
+		return fmt.Errorf("recorder: can't encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("recorder: can't save cassette: %w", err)
+	}
+
+	if err := os.WriteFile(path, contents, 0o644); err != nil {
+		return fmt.Errorf("recorder: can't save cassette: %w", err)
+	}
+
+	return nil
+}
+
+func isJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
@@ -0,0 +1,63 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// Matcher reports whether a request matches a recorded one, body is the scrubbed request body
+type Matcher func(r *http.Request, body []byte, recorded RecordedRequest) bool
+
+// DefaultMatchers returns matchers used when none are set by WithMatchers: method, path, query and body
+func DefaultMatchers() []Matcher {
+	return []Matcher{MatchMethod, MatchPath, MatchQuery, MatchBody}
+}
+
+// MatchMethod matches requests by HTTP method
+func MatchMethod(r *http.Request, _ []byte, recorded RecordedRequest) bool {
+	return r.Method == recorded.Method
+}
+
+// MatchPath matches requests by URL path, host is ignored so a cassette can be replayed against any endpoint
+func MatchPath(r *http.Request, _ []byte, recorded RecordedRequest) bool {
+	u, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return r.URL.Path == u.Path
+}
+
+// MatchQuery matches requests by query parameters regardless of their order
+func MatchQuery(r *http.Request, _ []byte, recorded RecordedRequest) bool {
+	u, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(normalizeQuery(r.URL.Query()), normalizeQuery(u.Query()))
+}
+
+// MatchBody matches requests by body, JSON bodies are compared semantically
+func MatchBody(_ *http.Request, body []byte, recorded RecordedRequest) bool {
+	recordedBody := []byte(recorded.Body)
+
+	var actual, expected interface{}
+
+	if json.Unmarshal(body, &actual) == nil && json.Unmarshal(recordedBody, &expected) == nil {
+		return reflect.DeepEqual(actual, expected)
+	}
+
+	return bytes.Equal(body, recordedBody)
+}
+
+func normalizeQuery(query url.Values) url.Values {
+	if len(query) == 0 {
+		return nil
+	}
+
+	return query
+}
@@ -0,0 +1,307 @@
+// Package recorder provides an http.RoundTripper which records the client traffic to cassettes
+// and replays it, so integration tests can run offline against realistic data.
+//
+// Record a cassette once against the real API:
+//
+//	rec, err := recorder.New("testdata/hosts.yaml", recorder.WithMode(recorder.ModeRecord))
+//	client := serverscom.NewClientWithOptions(token, serverscom.WithTransport(rec))
+//	...
+//	err = rec.Stop()
+//
+// Then replay it in tests:
+//
+//	rec, err := recorder.New("testdata/hosts.yaml")
+//	client := serverscom.NewClientWithOptions("token", serverscom.WithTransport(rec))
+//
+// Authorization header and known secret fields of bodies are scrubbed before a cassette is saved.
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/serverscom/serverscom-go-client/pkg/internal/redact"
+)
+
+// Mode describes what a recorder does with requests
+type Mode int
+
+const (
+	// ModeReplay responds with recorded interactions and never calls the API
+	ModeReplay Mode = iota
+
+	// ModeRecord performs requests with the real transport and records them
+	ModeRecord
+)
+
+// Option configures a recorder built by New
+type Option func(*Recorder)
+
+// WithMode sets a recorder mode, by default: ModeReplay
+func WithMode(mode Mode) Option {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithMatchers sets matchers used to find a recorded interaction for a request, by default DefaultMatchers is used
+func WithMatchers(matchers ...Matcher) Option {
+	return func(r *Recorder) {
+		r.matchers = matchers
+	}
+}
+
+// WithRealTransport sets a transport used to perform requests in ModeRecord, by default: http.DefaultTransport
+func WithRealTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithScrubbedFields adds JSON fields which values are scrubbed from bodies,
+// in addition to secret, password, private_key and token.
+func WithScrubbedFields(fields ...string) Option {
+	return func(r *Recorder) {
+		r.redactor.AddFields(fields...)
+	}
+}
+
+// WithScrubbedHeaders adds headers which values are scrubbed, in addition to Authorization, Cookie and Set-Cookie
+func WithScrubbedHeaders(headers ...string) Option {
+	return func(r *Recorder) {
+		r.redactor.AddHeaders(headers...)
+	}
+}
+
+// MismatchError is returned in ModeReplay when no unused recorded interaction matches a request
+type MismatchError struct {
+	Method   string
+	URL      string
+	Body     string
+	Cassette string
+}
+
+func (e *MismatchError) Error() string {
+	message := fmt.Sprintf("recorder: no unused interaction in cassette %s matches request %s %s", e.Cassette, e.Method, e.URL)
+
+	if e.Body != "" {
+		message += fmt.Sprintf(", with body: %s", e.Body)
+	}
+
+	return message
+}
+
+// Recorder is an http.RoundTripper which records or replays interactions, it's safe for concurrent use
+type Recorder struct {
+	path      string
+	mode      Mode
+	matchers  []Matcher
+	transport http.RoundTripper
+
+	redactor *redact.Redactor
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New builds a new recorder for a cassette file, in ModeReplay the cassette is loaded immediately
+func New(path string, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      ModeReplay,
+		matchers:  DefaultMatchers(),
+		transport: http.DefaultTransport,
+		redactor:  redact.New(),
+		cassette:  &Cassette{},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeReplay {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+
+		r.cassette = cassette
+		r.used = make([]bool, len(cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// Stop saves recorded interactions in ModeRecord, in ModeReplay it does nothing
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// Unused returns recorded interactions which weren't replayed yet, it's useful to ensure a scenario was finished
+func (r *Recorder) Unused() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []*Interaction
+
+	for i, used := range r.used {
+		if !used {
+			unused = append(unused, r.cassette.Interactions[i])
+		}
+	}
+
+	return unused
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+
+	return r.replay(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	outgoing := req.Clone(req.Context())
+	if body != nil {
+		outgoing.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.transport.RoundTrip(outgoing)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: r.scrubHeader(req.Header),
+			Body:   string(r.scrubBody(body)),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.scrubHeader(resp.Header),
+			Body:       string(r.scrubBody(respBody)),
+		},
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	scrubbed := r.scrubBody(body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !r.matches(req, scrubbed, interaction.Request) {
+			continue
+		}
+
+		r.used[i] = true
+
+		return newResponse(req, interaction.Response), nil
+	}
+
+	return nil, &MismatchError{
+		Method:   req.Method,
+		URL:      req.URL.String(),
+		Body:     string(scrubbed),
+		Cassette: r.path,
+	}
+}
+
+func (r *Recorder) matches(req *http.Request, body []byte, recorded RecordedRequest) bool {
+	for _, matcher := range r.matchers {
+		if !matcher(req, body, recorded) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newResponse(req *http.Request, recorded RecordedResponse) *http.Response {
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("recorder: can't read request body: %w", err)
+	}
+
+	return body, nil
+}
+
+// scrubHeader returns a copy of the header with values of sensitive headers replaced,
+// Content-Length is dropped since scrubbing may change the body length.
+func (r *Recorder) scrubHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+
+	result := r.redactor.Header(header)
+	result.Del("Content-Length")
+
+	return result
+}
+
+// scrubBody returns the body with values of sensitive JSON fields replaced, other bodies are returned as is
+func (r *Recorder) scrubBody(body []byte) []byte {
+	return r.redactor.Body(body)
+}
@@ -0,0 +1,179 @@
+package recorder
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	serverscom "github.com/serverscom/serverscom-go-client/pkg"
+	"github.com/serverscom/serverscom-go-client/pkg/internal/redact"
+)
+
+const (
+	testToken  = "very_secret_token"
+	testSecret = "supersecret"
+)
+
+func newTestAPI() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/hosts/dedicated_servers/a/oob_credentials":
+			w.Write([]byte(`{"login": "admin", "secret": "` + testSecret + `"}`))
+		case "/v1/ssl_certificates/custom":
+			body, _ := io.ReadAll(r.Body)
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write(append([]byte(`{"id": "cert", "request": `), append(body, '}')...))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code": "NOT_FOUND", "message": "Not found"}`))
+		}
+	}))
+}
+
+func recordScenario(g *WithT, path string) {
+	ts := newTestAPI()
+	defer ts.Close()
+
+	rec, err := New(path, WithMode(ModeRecord))
+	g.Expect(err).To(BeNil())
+
+	client := serverscom.NewClientWithOptions(
+		testToken,
+		serverscom.WithBaseURL(ts.URL+"/v1"),
+		serverscom.WithTransport(rec),
+	)
+
+	runScenario(g, client)
+
+	g.Expect(rec.Stop()).To(Succeed())
+}
+
+func runScenario(g *WithT, client *serverscom.Client) {
+	ctx := context.TODO()
+
+	credentials, err := client.Hosts.GetDedicatedServerOOBCredentials(ctx, "a", map[string]string{"reason": "test"})
+	g.Expect(err).To(BeNil())
+	g.Expect(credentials.Login).To(Equal("admin"))
+
+	cert, err := client.SSLCertificates.CreateCustom(ctx, serverscom.SSLCertificateCreateCustomInput{
+		Name:       "cert",
+		PublicKey:  "public",
+		PrivateKey: "private",
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(cert.ID).To(Equal("cert"))
+
+	_, err = client.SSHKeys.Get(ctx, "missing")
+	g.Expect(err).To(BeAssignableToTypeOf(&serverscom.NotFoundError{}))
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	for _, name := range []string{"cassette.yaml", "cassette.json"} {
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			path := filepath.Join(t.TempDir(), "testdata", name)
+
+			recordScenario(g, path)
+
+			contents, err := os.ReadFile(path)
+			g.Expect(err).To(BeNil())
+			g.Expect(string(contents)).NotTo(ContainSubstring(testToken))
+			g.Expect(string(contents)).NotTo(ContainSubstring(testSecret))
+			g.Expect(string(contents)).NotTo(ContainSubstring(`"private"`))
+			g.Expect(string(contents)).To(ContainSubstring(redact.Placeholder))
+
+			cassette, err := LoadCassette(path)
+			g.Expect(err).To(BeNil())
+			g.Expect(cassette.Interactions).To(HaveLen(3))
+
+			rec, err := New(path)
+			g.Expect(err).To(BeNil())
+
+			client := serverscom.NewClientWithOptions(
+				"another_token",
+				serverscom.WithBaseURL("http://replay.invalid/v1"),
+				serverscom.WithTransport(rec),
+			)
+
+			runScenario(g, client)
+
+			g.Expect(rec.Unused()).To(BeEmpty())
+		})
+	}
+}
+
+func TestReplayMismatch(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+
+	recordScenario(g, path)
+
+	rec, err := New(path)
+	g.Expect(err).To(BeNil())
+
+	client := serverscom.NewClientWithOptions(
+		"token",
+		serverscom.WithBaseURL("http://replay.invalid/v1"),
+		serverscom.WithTransport(rec),
+	)
+
+	ctx := context.TODO()
+
+	_, err = client.Hosts.GetDedicatedServerOOBCredentials(ctx, "a", map[string]string{"reason": "other"})
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("no unused interaction in cassette " + path))
+	g.Expect(err.Error()).To(ContainSubstring("GET http://replay.invalid/v1/hosts/dedicated_servers/a/oob_credentials?reason=other"))
+
+	_, err = client.Hosts.GetDedicatedServerOOBCredentials(ctx, "a", map[string]string{"reason": "test"})
+	g.Expect(err).To(BeNil())
+
+	_, err = client.Hosts.GetDedicatedServerOOBCredentials(ctx, "a", map[string]string{"reason": "test"})
+	g.Expect(err).NotTo(BeNil())
+
+	g.Expect(rec.Unused()).To(HaveLen(2))
+}
+
+func TestReplayMatchers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recordScenario(g, path)
+
+	rec, err := New(path, WithMatchers(MatchMethod, MatchPath))
+	g.Expect(err).To(BeNil())
+
+	req := httptest.NewRequest("GET", "http://replay.invalid/v1/hosts/dedicated_servers/a/oob_credentials?reason=other", nil)
+
+	resp, err := rec.RoundTrip(req)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+	req = httptest.NewRequest("DELETE", "http://replay.invalid/v1/ssh_keys/missing", nil)
+
+	_, err = rec.RoundTrip(req)
+
+	var mismatch *MismatchError
+	g.Expect(errors.As(err, &mismatch)).To(BeTrue())
+	g.Expect(mismatch.Method).To(Equal("DELETE"))
+}
+
+func TestNewWithMissingCassette(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := New(filepath.Join(t.TempDir(), "missing.yaml"))
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+}
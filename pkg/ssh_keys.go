@@ -32,12 +32,13 @@ type SSHKeysHandler struct {
 // Collection builds a new Collection[SSHKey] interface
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/SSH-Key/operation/ListSshKeys
 func (h *SSHKeysHandler) Collection() Collection[SSHKey] {
-	return NewCollection[SSHKey](h.client, sshKeyListPath)
+	return NewCollection[SSHKey](h.client, sshKeyListPath).withOperation("SSHKeys.Collection", sshKeyListPath)
 }
 
 // Get ssh key
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/SSH-Key/operation/GetAnSshKey
 func (h *SSHKeysHandler) Get(ctx context.Context, fingerprint string) (*SSHKey, error) {
+	ctx = withOperation(ctx, "SSHKeys.Get", sshKeyPath, fingerprint)
 	url := h.client.buildURL(sshKeyPath, []interface{}{fingerprint}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -64,6 +65,8 @@ func (h *SSHKeysHandler) Create(ctx context.Context, input SSHKeyCreateInput) (*
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "SSHKeys.Create", sshKetCreatePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(sshKetCreatePath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -90,6 +93,8 @@ func (h *SSHKeysHandler) Update(ctx context.Context, fingerprint string, input S
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "SSHKeys.Update", sshKeyPath, fingerprint)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(sshKeyPath, []interface{}{fingerprint}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -110,6 +115,7 @@ func (h *SSHKeysHandler) Update(ctx context.Context, fingerprint string, input S
 // Delete ssh key
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/SSH-Key/operation/DeleteAnSshKey
 func (h *SSHKeysHandler) Delete(ctx context.Context, fingerprint string) error {
+	ctx = withOperation(ctx, "SSHKeys.Delete", sshKeyPath, fingerprint)
 	url := h.client.buildURL(sshKeyPath, []interface{}{fingerprint}...)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
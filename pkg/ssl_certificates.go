@@ -35,7 +35,7 @@ type SSLCertificatesHandler struct {
 
 // Collection builds a new Collection[SSLCertificate] interface
 func (h *SSLCertificatesHandler) Collection() Collection[SSLCertificate] {
-	return NewCollection[SSLCertificate](h.client, sslCertificateListPath)
+	return NewCollection[SSLCertificate](h.client, sslCertificateListPath).withOperation("SSLCertificates.Collection", sslCertificateListPath)
 }
 
 // CreateCustom creates a custom ssl certificate
@@ -46,6 +46,8 @@ func (h *SSLCertificatesHandler) CreateCustom(ctx context.Context, input SSLCert
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "SSLCertificates.CreateCustom", sslCreatificatedCreatePath)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(sslCreatificatedCreatePath)
 
 	body, err := h.client.buildAndExecRequest(ctx, "POST", url, payload)
@@ -65,6 +67,7 @@ func (h *SSLCertificatesHandler) CreateCustom(ctx context.Context, input SSLCert
 
 // GetCustom returns a custom ssl certificate
 func (h *SSLCertificatesHandler) GetCustom(ctx context.Context, id string) (*SSLCertificateCustom, error) {
+	ctx = withOperation(ctx, "SSLCertificates.GetCustom", sslCertificatePath, id)
 	url := h.client.buildURL(sslCertificatePath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -91,6 +94,8 @@ func (h *SSLCertificatesHandler) UpdateCustom(ctx context.Context, id string, in
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "SSLCertificates.UpdateCustom", sslCertificatePath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(sslCertificatePath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -110,6 +115,7 @@ func (h *SSLCertificatesHandler) UpdateCustom(ctx context.Context, id string, in
 // DeleteCustom deletes a custom SSL certificate
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/SSL-Certificate/operation/DeleteACustomSslCertificate
 func (h *SSLCertificatesHandler) DeleteCustom(ctx context.Context, id string) error {
+	ctx = withOperation(ctx, "SSLCertificates.DeleteCustom", sslCertificatePath, id)
 	url := h.client.buildURL(sslCertificatePath, []interface{}{id}...)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -119,6 +125,7 @@ func (h *SSLCertificatesHandler) DeleteCustom(ctx context.Context, id string) er
 
 // GetLE returns a Let's Encrypt SSL certificate
 func (h *SSLCertificatesHandler) GetLE(ctx context.Context, id string) (*SSLCertificateLE, error) {
+	ctx = withOperation(ctx, "SSLCertificates.GetLE", sslCertificateLEPath, id)
 	url := h.client.buildURL(sslCertificateLEPath, id)
 
 	body, err := h.client.buildAndExecRequest(ctx, "GET", url, nil)
@@ -145,6 +152,8 @@ func (h *SSLCertificatesHandler) UpdateLE(ctx context.Context, id string, input
 		return nil, err
 	}
 
+	ctx = withOperation(ctx, "SSLCertificates.UpdateLE", sslCertificateLEPath, id)
+	ctx = withInput(ctx, input)
 	url := h.client.buildURL(sslCertificateLEPath, []interface{}{id}...)
 
 	body, err := h.client.buildAndExecRequest(ctx, "PUT", url, payload)
@@ -164,6 +173,7 @@ func (h *SSLCertificatesHandler) UpdateLE(ctx context.Context, id string, input
 // DeleteLE deletes a Let's Encrypt SSL certificate
 // Endpoint: https://developers.servers.com/api-documentation/v1/#tag/SSL-Certificate/operation/DeleteALetsEncryptSslCertificate
 func (h *SSLCertificatesHandler) DeleteLE(ctx context.Context, id string) error {
+	ctx = withOperation(ctx, "SSLCertificates.DeleteLE", sslCertificateLEPath, id)
 	url := h.client.buildURL(sslCertificateLEPath, []interface{}{id}...)
 
 	_, err := h.client.buildAndExecRequest(ctx, "DELETE", url, nil)
@@ -138,6 +138,10 @@ func (fs *fakeServer) WithResponseBodyStubFile(filePath string) *fakeServer {
 }
 
 func (fs *fakeServer) Build() (*fakeServer, *Client) {
+	return fs.BuildWithOptions()
+}
+
+func (fs *fakeServer) BuildWithOptions(opts ...Option) (*fakeServer, *Client) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		if len(fs.Requests) == 0 {
@@ -216,7 +220,8 @@ func (fs *fakeServer) Build() (*fakeServer, *Client) {
 
 	fs.Server = ts
 
-	client := NewClientWithEndpoint("testing_token", fmt.Sprintf("%s/v1", ts.URL))
+	opts = append([]Option{WithBaseURL(fmt.Sprintf("%s/v1", ts.URL))}, opts...)
+	client := NewClientWithOptions("testing_token", opts...)
 
 	return fs, client
 }
@@ -0,0 +1,232 @@
+package serverscom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultTokenCommandTimeout = 30 * time.Second
+
+// TokenSource is an interface for supplying API tokens, it's consulted before each request.
+//
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// TokenRefresher is an optional interface for token sources which cache a token.
+//
+// When the API responds with 401 the client calls RefreshToken once and retries the request
+// with the new token in case it differs from the rejected one. Token sources which don't implement
+// this interface are asked for a token by the Token method instead.
+type TokenRefresher interface {
+	RefreshToken() (string, error)
+}
+
+// StaticTokenSource returns a TokenSource which always returns the same token
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token() (string, error) {
+	return string(s), nil
+}
+
+// EnvTokenSource returns a TokenSource which reads a token from the environment variable on each request
+func EnvTokenSource(name string) TokenSource {
+	return envTokenSource(name)
+}
+
+type envTokenSource string
+
+func (s envTokenSource) Token() (string, error) {
+	token := strings.TrimSpace(os.Getenv(string(s)))
+	if token == "" {
+		return "", fmt.Errorf("Environment variable %s is empty", string(s))
+	}
+
+	return token, nil
+}
+
+// FileTokenSource returns a TokenSource which reads a token from the file, the file is re-read when
+// its modification time or size changes.
+func FileTokenSource(path string) TokenSource {
+	return &fileTokenSource{path: path}
+}
+
+type fileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+func (s *fileTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", err
+	}
+
+	if s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	return s.read(info)
+}
+
+func (s *fileTokenSource) RefreshToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", err
+	}
+
+	return s.read(info)
+}
+
+func (s *fileTokenSource) read(info os.FileInfo) (string, error) {
+	contents, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(contents))
+	if token == "" {
+		return "", fmt.Errorf("Token file %s is empty", s.path)
+	}
+
+	s.token = token
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+
+	return token, nil
+}
+
+// CommandTokenSource returns a TokenSource which runs the command with sh -c and uses its trimmed output as a token,
+// e.g. "pass show serverscom". The token is cached until the API rejects it.
+//
+// The command is killed if it doesn't finish in 30 seconds, see CommandTokenSourceWithTimeout.
+func CommandTokenSource(command string) TokenSource {
+	return CommandTokenSourceWithTimeout(command, defaultTokenCommandTimeout)
+}
+
+// CommandTokenSourceWithTimeout works like CommandTokenSource, but kills the command if it doesn't finish
+// within the timeout, a zero or negative timeout means the default one: 30 seconds.
+func CommandTokenSourceWithTimeout(command string, timeout time.Duration) TokenSource {
+	if timeout <= 0 {
+		timeout = defaultTokenCommandTimeout
+	}
+
+	return &commandTokenSource{command: command, timeout: timeout}
+}
+
+type commandTokenSource struct {
+	command string
+	timeout time.Duration
+
+	mu    sync.Mutex
+	token string
+}
+
+func (s *commandTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" {
+		return s.token, nil
+	}
+
+	return s.run()
+}
+
+func (s *commandTokenSource) RefreshToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.run()
+}
+
+func (s *commandTokenSource) run() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", s.command)
+	// children of sh may keep the output open after sh is killed
+	cmd.WaitDelay = time.Second
+
+	output, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("Token command %q timed out after %s", s.command, s.timeout)
+		}
+
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return "", fmt.Errorf("Token command %q failed: %w: %s", s.command, err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+
+		return "", fmt.Errorf("Token command %q failed: %w", s.command, err)
+	}
+
+	token := strings.TrimSpace(string(output))
+	if token == "" {
+		return "", fmt.Errorf("Token command %q returned an empty token", s.command)
+	}
+
+	s.token = token
+
+	return token, nil
+}
+
+// SetTokenSource sets a token source for client, it replaces the token passed to the constructor
+func (cli *Client) SetTokenSource(source TokenSource) {
+	cli.tokenSource = source
+}
+
+func (cli *Client) token() (string, error) {
+	if cli.tokenSource == nil {
+		return "", nil
+	}
+
+	token, err := cli.tokenSource.Token()
+	if err != nil {
+		return "", fmt.Errorf("Client token error: %w", err)
+	}
+
+	return token, nil
+}
+
+// refreshToken returns true when the token source provides a token different from the rejected one
+func (cli *Client) refreshToken(rejected string) bool {
+	if cli.tokenSource == nil {
+		return false
+	}
+
+	var (
+		token string
+		err   error
+	)
+
+	if refresher, ok := cli.tokenSource.(TokenRefresher); ok {
+		token, err = refresher.RefreshToken()
+	} else {
+		token, err = cli.tokenSource.Token()
+	}
+
+	return err == nil && token != "" && token != rejected
+}
@@ -0,0 +1,221 @@
+package serverscom
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type rotatingTokenSource struct {
+	mu     sync.Mutex
+	tokens []string
+}
+
+func (s *rotatingTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tokens[0], nil
+}
+
+func (s *rotatingTokenSource) RefreshToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.tokens) > 1 {
+		s.tokens = s.tokens[1:]
+	}
+
+	return s.tokens[0], nil
+}
+
+func authorizationRecorder(headers *[]string) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		*headers = append(*headers, r.Header.Get("Authorization"))
+
+		return http.DefaultTransport.RoundTrip(r)
+	})
+}
+
+func TestStaticTokenSource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	token, err := StaticTokenSource("static").Token()
+
+	g.Expect(err).To(BeNil())
+	g.Expect(token).To(Equal("static"))
+}
+
+func TestEnvTokenSource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	source := EnvTokenSource("SC_TEST_TOKEN")
+
+	t.Setenv("SC_TEST_TOKEN", "")
+
+	_, err := source.Token()
+	g.Expect(err).NotTo(BeNil())
+
+	t.Setenv("SC_TEST_TOKEN", "from-env\n")
+
+	token, err := source.Token()
+	g.Expect(err).To(BeNil())
+	g.Expect(token).To(Equal("from-env"))
+}
+
+func TestFileTokenSource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := filepath.Join(t.TempDir(), "token")
+
+	g.Expect(os.WriteFile(path, []byte("first\n"), 0600)).To(Succeed())
+
+	source := FileTokenSource(path)
+
+	token, err := source.Token()
+	g.Expect(err).To(BeNil())
+	g.Expect(token).To(Equal("first"))
+
+	g.Expect(os.WriteFile(path, []byte("second-token\n"), 0600)).To(Succeed())
+	g.Expect(os.Chtimes(path, time.Now(), time.Now().Add(time.Minute))).To(Succeed())
+
+	token, err = source.Token()
+	g.Expect(err).To(BeNil())
+	g.Expect(token).To(Equal("second-token"))
+
+	g.Expect(os.Remove(path)).To(Succeed())
+
+	_, err = source.Token()
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	token, err := CommandTokenSource("echo ' command_token '").Token()
+	g.Expect(err).To(BeNil())
+	g.Expect(token).To(Equal("command_token"))
+
+	_, err = CommandTokenSource("echo 'vault is sealed' >&2; exit 2").Token()
+	g.Expect(err).To(MatchError(`Token command "echo 'vault is sealed' >&2; exit 2" failed: exit status 2: vault is sealed`))
+
+	startedAt := time.Now()
+
+	_, err = CommandTokenSourceWithTimeout("sleep 10", 100*time.Millisecond).Token()
+	g.Expect(err).To(MatchError(`Token command "sleep 10" timed out after 100ms`))
+	g.Expect(time.Since(startedAt)).To(BeNumerically("<", 5*time.Second))
+}
+
+func TestTokenSourceIsConsultedPerRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var headers []string
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		BuildWithOptions(WithTransport(authorizationRecorder(&headers)))
+
+	defer ts.Close()
+
+	t.Setenv("SC_TEST_TOKEN", "first")
+	client.SetTokenSource(EnvTokenSource("SC_TEST_TOKEN"))
+
+	ctx := context.TODO()
+
+	_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeNil())
+
+	t.Setenv("SC_TEST_TOKEN", "second")
+
+	_, err = client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeNil())
+
+	g.Expect(headers).To(Equal([]string{"Bearer first", "Bearer second"}))
+}
+
+func TestTokenSourceRefreshOnUnauthorized(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var headers []string
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/"+sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "UNAUTHORIZED", "message": "Token expired"}`).
+		WithResponseCode(401).
+		Next().
+		WithRequestPath("/ssh_keys/"+sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		BuildWithOptions(
+			WithTransport(authorizationRecorder(&headers)),
+			WithTokenSource(&rotatingTokenSource{tokens: []string{"expired", "fresh"}}),
+		)
+
+	defer ts.Close()
+
+	SSHKey, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(SSHKey).NotTo(BeNil())
+	g.Expect(headers).To(Equal([]string{"Bearer expired", "Bearer fresh"}))
+}
+
+func TestTokenSourceRefreshesOnlyOnce(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "UNAUTHORIZED", "message": "Token expired"}`).
+		WithResponseCode(401).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "UNAUTHORIZED", "message": "Token revoked"}`).
+		WithResponseCode(401).
+		Next().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		BuildWithOptions(
+			WithTokenSource(&rotatingTokenSource{tokens: []string{"expired", "revoked", "fresh"}}),
+		)
+
+	defer ts.Close()
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+
+	g.Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+	g.Expect(len(ts.Requests)).To(Equal(1))
+}
+
+func TestTokenSourceWithoutNewToken(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "UNAUTHORIZED", "message": "Invalid token"}`).
+		WithResponseCode(401).
+		Build()
+
+	defer ts.Close()
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+
+	g.Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
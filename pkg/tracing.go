@@ -0,0 +1,145 @@
+package serverscom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	tracerName = "github.com/serverscom/serverscom-go-client"
+
+	resourceIDAttributeKey = attribute.Key("serverscom.resource_id")
+	cacheAttributeKey      = attribute.Key("serverscom.cache")
+)
+
+// WithTracerProvider enables OpenTelemetry tracing.
+//
+// Each API call produces a client span named after the service and the method, e.g. Hosts.PowerCycleDedicatedServer,
+// Collect, CollectParallel, Pages and All produce a parent span named after the method, e.g.
+// Hosts.Collection.CollectParallel, with a child span per page. Trace context is propagated to the API
+// with W3C Trace Context and Baggage headers unless another propagator is set by WithPropagator.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *clientOptions) {
+		o.tracerProvider = provider
+	}
+}
+
+// WithPropagator sets a propagator used to inject trace context into requests, it's used only with WithTracerProvider
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *clientOptions) {
+		o.propagator = propagator
+	}
+}
+
+type tracing struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+func newTracing(provider trace.TracerProvider, propagator propagation.TextMapPropagator) tracing {
+	if provider == nil {
+		return tracing{
+			tracer:     noop.NewTracerProvider().Tracer(tracerName),
+			propagator: propagation.NewCompositeTextMapPropagator(),
+		}
+	}
+
+	if propagator == nil {
+		propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	}
+
+	return tracing{
+		tracer:     provider.Tracer(tracerName),
+		propagator: propagator,
+	}
+}
+
+// startSpan starts a span for an API call described by the operation attached to the context
+func (t tracing) startSpan(ctx context.Context, method, endpointURL string) (context.Context, trace.Span) {
+	op, _ := operationFromContext(ctx)
+
+	name := op.Name
+	if name == "" {
+		name = method
+	}
+
+	attributes := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(method),
+		semconv.URLFull(endpointURL),
+	}
+
+	if u, err := url.Parse(endpointURL); err == nil {
+		attributes = append(attributes, semconv.ServerAddress(u.Hostname()))
+	}
+
+	if op.Path != "" {
+		attributes = append(attributes, semconv.HTTPRoute(op.Path))
+	}
+
+	if op.ResourceID != "" {
+		attributes = append(attributes, resourceIDAttributeKey.String(op.ResourceID))
+	}
+
+	return t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+}
+
+// startCollectSpan starts a parent span for pages fetched by the collection method, e.g. Collect or Pages,
+// the span is named after the operation and the method: Hosts.Collection.CollectParallel
+func (t tracing) startCollectSpan(ctx context.Context, op operation, method string) (context.Context, trace.Span) {
+	name := op.Name
+	if name == "" {
+		name = "Collection"
+	}
+
+	var attributes []attribute.KeyValue
+
+	if op.Path != "" {
+		attributes = append(attributes, semconv.HTTPRoute(op.Path))
+	}
+
+	if op.ResourceID != "" {
+		attributes = append(attributes, resourceIDAttributeKey.String(op.ResourceID))
+	}
+
+	return t.tracer.Start(ctx, name+"."+method, trace.WithAttributes(attributes...))
+}
+
+func (t tracing) inject(ctx context.Context, header http.Header) {
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// endSpan records the response status and the error, and then ends the span
+func endSpan(span trace.Span, resp *Response, err error) {
+	if resp != nil {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	}
+
+	if err != nil {
+		span.SetAttributes(semconv.ErrorTypeKey.String(errorType(err)))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// errorType returns a name of typed errors from this package, e.g. NotFoundError, and _OTHER for the rest
+func errorType(err error) string {
+	name := fmt.Sprintf("%T", err)
+
+	if !strings.HasPrefix(name, "*serverscom.") {
+		return "_OTHER"
+	}
+
+	return strings.TrimPrefix(name, "*serverscom.")
+}
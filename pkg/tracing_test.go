@@ -0,0 +1,252 @@
+package serverscom
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestTracerProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)), exporter
+}
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value)
+
+	for _, kv := range span.Attributes {
+		attributes[kv.Key] = kv.Value
+	}
+
+	return attributes
+}
+
+func TestTracingSpanPerCall(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	provider, exporter := newTestTracerProvider()
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers/" + serverID + "/power_cycle").
+		WithRequestMethod("POST").
+		WithResponseBodyStubFile("fixtures/hosts/dedicated_servers/get_response.json").
+		WithResponseCode(202).
+		BuildWithOptions(WithTracerProvider(provider))
+
+	defer ts.Close()
+
+	_, err := client.Hosts.PowerCycleDedicatedServer(context.TODO(), serverID)
+	g.Expect(err).To(BeNil())
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(1))
+
+	span := spans[0]
+	attributes := spanAttributes(span)
+
+	g.Expect(span.Name).To(Equal("Hosts.PowerCycleDedicatedServer"))
+	g.Expect(span.SpanKind).To(Equal(trace.SpanKindClient))
+	g.Expect(span.Status.Code).To(Equal(codes.Unset))
+	g.Expect(attributes["http.request.method"].AsString()).To(Equal("POST"))
+	g.Expect(attributes["http.route"].AsString()).To(Equal("/hosts/dedicated_servers/%s/power_cycle"))
+	g.Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(202)))
+	g.Expect(attributes["serverscom.resource_id"].AsString()).To(Equal(serverID))
+	g.Expect(attributes["url.full"].AsString()).To(Equal(ts.Server.URL + "/v1/hosts/dedicated_servers/" + serverID + "/power_cycle"))
+}
+
+func TestTracingRecordsTypedError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	provider, exporter := newTestTracerProvider()
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers/" + serverID).
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"code": "NOT_FOUND", "message": "Server not found"}`).
+		WithResponseCode(404).
+		BuildWithOptions(WithTracerProvider(provider))
+
+	defer ts.Close()
+
+	_, err := client.Hosts.GetDedicatedServer(context.TODO(), serverID)
+	g.Expect(err).To(BeAssignableToTypeOf(&NotFoundError{}))
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(1))
+
+	attributes := spanAttributes(spans[0])
+
+	g.Expect(spans[0].Name).To(Equal("Hosts.GetDedicatedServer"))
+	g.Expect(spans[0].Status.Code).To(Equal(codes.Error))
+	g.Expect(attributes["error.type"].AsString()).To(Equal("NotFoundError"))
+	g.Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(404)))
+	g.Expect(spans[0].Events).To(HaveLen(1))
+	g.Expect(spans[0].Events[0].Name).To(Equal("exception"))
+}
+
+func TestTracingPropagatesTraceContext(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	provider, exporter := newTestTracerProvider()
+
+	var traceparents []string
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		traceparents = append(traceparents, r.Header.Get("Traceparent"))
+
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/"+sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		BuildWithOptions(WithTracerProvider(provider), WithTransport(transport))
+
+	defer ts.Close()
+
+	_, err := client.SSHKeys.Get(context.TODO(), sshFingerprint)
+	g.Expect(err).To(BeNil())
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(1))
+
+	spanContext := spans[0].SpanContext
+
+	g.Expect(traceparents).To(Equal([]string{
+		"00-" + spanContext.TraceID().String() + "-" + spanContext.SpanID().String() + "-01",
+	}))
+}
+
+func TestTracingDisabledByDefault(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var traceparents []string
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		traceparents = append(traceparents, r.Header.Get("Traceparent"))
+
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	provider, exporter := newTestTracerProvider()
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+
+	ts, client := newFakeServer().
+		WithRequestPath("/ssh_keys/" + sshFingerprint).
+		WithRequestMethod("GET").
+		WithResponseBodyStubFile("fixtures/ssh_keys/get_response.json").
+		BuildWithOptions(WithTransport(transport))
+
+	defer ts.Close()
+
+	_, err := client.SSHKeys.Get(ctx, sshFingerprint)
+	g.Expect(err).To(BeNil())
+
+	parent.End()
+
+	g.Expect(traceparents).To(Equal([]string{""}))
+	g.Expect(exporter.GetSpans()).To(HaveLen(1))
+}
+
+func TestTracingCollectSpans(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	provider, exporter := newTestTracerProvider()
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers/" + serverID + "/ptr_records").
+		WithRequestMethod("GET").
+		WithResponseHeaders(map[string]string{
+			"Link": `<https://dummy.api.com/hosts/dedicated_servers/a/ptr_records?page=2&per_page=1>; rel="next"`,
+		}).
+		WithResponseBodyStubInline(`[{"id": "a"}]`).
+		Next().
+		WithRequestPath("/hosts/dedicated_servers/" + serverID + "/ptr_records").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`[{"id": "b"}]`).
+		BuildWithOptions(WithTracerProvider(provider))
+
+	defer ts.Close()
+
+	list, err := client.Hosts.DedicatedServerPTRRecords(serverID).Collect(context.TODO())
+	g.Expect(err).To(BeNil())
+	g.Expect(list).To(HaveLen(2))
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(3))
+
+	parent := spans[2]
+
+	g.Expect(parent.Name).To(Equal("Hosts.DedicatedServerPTRRecords.Collect"))
+	g.Expect(spanAttributes(parent)["serverscom.resource_id"].AsString()).To(Equal(serverID))
+
+	for _, page := range spans[:2] {
+		g.Expect(page.Name).To(Equal("Hosts.DedicatedServerPTRRecords"))
+		g.Expect(page.Parent.SpanID()).To(Equal(parent.SpanContext.SpanID()))
+		g.Expect(spanAttributes(page)["http.route"].AsString()).To(Equal("/hosts/%s/%s/ptr_records"))
+	}
+}
+
+func TestTracingCollectSpanNamedByMethod(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	provider, exporter := newTestTracerProvider()
+
+	builder := newFakeServer()
+
+	for i := 0; i < 4; i++ {
+		if i > 0 {
+			builder = builder.Next()
+		}
+
+		builder = builder.
+			WithRequestPath("/hosts").
+			WithRequestMethod("GET").
+			WithResponseBodyStubInline(`[{"id": "a"}]`)
+	}
+
+	ts, client := builder.BuildWithOptions(WithTracerProvider(provider))
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+	collection := client.Hosts.Collection()
+
+	_, err := collection.Collect(ctx)
+	g.Expect(err).To(BeNil())
+
+	_, err = collection.CollectParallel(ctx, 2)
+	g.Expect(err).To(BeNil())
+
+	for _, err := range collection.Pages(ctx) {
+		g.Expect(err).To(BeNil())
+	}
+
+	for _, err := range collection.All(ctx) {
+		g.Expect(err).To(BeNil())
+	}
+
+	var names []string
+
+	for _, span := range exporter.GetSpans() {
+		if span.SpanKind != trace.SpanKindClient {
+			names = append(names, span.Name)
+		}
+	}
+
+	g.Expect(names).To(Equal([]string{
+		"Hosts.Collection.Collect",
+		"Hosts.Collection.CollectParallel",
+		"Hosts.Collection.Pages",
+		"Hosts.Collection.All",
+	}))
+}
@@ -0,0 +1,206 @@
+package serverscom
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValidationError is returned by Validate methods of inputs, it lists errors of each invalid field
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+
+	for _, fieldError := range e.Errors {
+		messages = append(messages, fieldError.Path+": "+strings.Join(fieldError.Messages, ", "))
+	}
+
+	return fmt.Sprintf("Input validation error: %s", strings.Join(messages, "; "))
+}
+
+// FieldErrors returns errors of each field sorted by path, like UnprocessableEntityError.FieldErrors
+func (e *ValidationError) FieldErrors() []FieldError {
+	return e.Errors
+}
+
+// WithInputValidation makes the client call Validate on inputs before sending them,
+// a *ValidationError is returned without sending a request if the input is invalid.
+func WithInputValidation() Option {
+	return func(o *clientOptions) {
+		o.inputValidation = true
+	}
+}
+
+type validatable interface {
+	Validate() error
+}
+
+// validateInput validates the input attached to the context, if input validation or dry-run mode is enabled
+func (cli *Client) validateInput(ctx context.Context) error {
+	if !cli.inputValidation && cli.dryRunPlan == nil {
+		return nil
+	}
+
+	input, ok := inputFromContext(ctx).(validatable)
+	if !ok {
+		return nil
+	}
+
+	return input.Validate()
+}
+
+// inputValidator collects field errors of an input
+type inputValidator struct {
+	errors fieldErrors
+}
+
+func (v *inputValidator) add(path, message string) {
+	for i := range v.errors {
+		if v.errors[i].Path == path {
+			v.errors[i].Messages = append(v.errors[i].Messages, message)
+
+			return
+		}
+	}
+
+	v.errors = append(v.errors, FieldError{Path: path, Messages: []string{message}})
+}
+
+func (v *inputValidator) check(ok bool, path, message string) {
+	if !ok {
+		v.add(path, message)
+	}
+}
+
+// nested adds errors returned by Validate of a nested input under the path
+func (v *inputValidator) nested(path string, err error) {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return
+	}
+
+	for _, fieldError := range validationErr.Errors {
+		nestedPath := path + "." + fieldError.Path
+		if strings.HasPrefix(fieldError.Path, "[") {
+			nestedPath = path + fieldError.Path
+		}
+
+		for _, message := range fieldError.Messages {
+			v.add(nestedPath, message)
+		}
+	}
+}
+
+func (v *inputValidator) required(value string, path string) {
+	v.check(strings.TrimSpace(value) != "", path, "can't be blank")
+}
+
+func (v *inputValidator) positive(value int64, path string) {
+	v.check(value > 0, path, "must be greater than 0")
+}
+
+func (v *inputValidator) notNegative(value int64, path string) {
+	v.check(value >= 0, path, "must be greater than or equal to 0")
+}
+
+func (v *inputValidator) oneOf(value string, path string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+
+	v.add(path, "must be one of: "+strings.Join(allowed, ", "))
+}
+
+func (v *inputValidator) port(value int32, path string) {
+	v.check(value >= 1 && value <= 65535, path, "must be between 1 and 65535")
+}
+
+func (v *inputValidator) ip(value string, path string) {
+	v.check(net.ParseIP(value) != nil, path, "is not a valid IP address")
+}
+
+func (v *inputValidator) cidr(value string, path string) {
+	_, _, err := net.ParseCIDR(value)
+
+	v.check(err == nil, path, "is not a valid CIDR")
+}
+
+func (v *inputValidator) mask(value int, path string) {
+	v.check(value >= 1 && value <= 32, path, "must be between 1 and 32")
+}
+
+func (v *inputValidator) hostname(value string, path string) {
+	v.check(isHostname(value), path, "is not a valid hostname")
+}
+
+func (v *inputValidator) pemEncoded(value string, path string) {
+	block, _ := pem.Decode([]byte(value))
+
+	v.check(block != nil, path, "is not PEM encoded")
+}
+
+func (v *inputValidator) rfc3339(value string, path string) {
+	_, err := time.Parse(time.RFC3339, value)
+
+	v.check(err == nil, path, "is not a RFC 3339 time")
+}
+
+func (v *inputValidator) labels(labels map[string]string, path string) {
+	for key := range labels {
+		v.check(strings.TrimSpace(key) != "", path, "keys can't be blank")
+	}
+}
+
+func (v *inputValidator) notEmpty(length int, path string) {
+	v.check(length > 0, path, "can't be empty")
+}
+
+// result returns a *ValidationError with fields sorted by path and mapped to the input, or nil
+func (v *inputValidator) result(input interface{}) error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(v.errors, func(i, j int) bool {
+		return v.errors[i].Path < v.errors[j].Path
+	})
+
+	return &ValidationError{Errors: v.errors.withInput(input)}
+}
+
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+func isHostname(value string) bool {
+	value = strings.TrimSuffix(value, ".")
+
+	if value == "" || len(value) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(value, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,172 @@
+package serverscom
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func validationErrors(err error) []FieldError {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+
+	return validationErr.FieldErrors()
+}
+
+func TestDedicatedServerCreateInputValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	input := DedicatedServerCreateInput{
+		ServerModelID: 1,
+		LocationID:    1,
+		RAMSize:       32,
+		UplinkModels: DedicatedServerUplinkModelsInput{
+			Private: DedicatedServerPrivateUplinkInput{ID: 1},
+		},
+		Drives: DedicatedServerDrivesInput{
+			Slots: []DedicatedServerSlotInput{{Position: 0}, {Position: 1}},
+			Layout: []DedicatedServerLayoutInput{
+				{
+					SlotPositions: []int{0, 2},
+					Partitions: []DedicatedServerLayoutPartitionInput{
+						{Target: "swap", Size: 4096},
+						{Target: "/", Size: 0},
+					},
+				},
+			},
+		},
+	}
+
+	g.Expect(validationErrors(input.Validate())).To(Equal([]FieldError{
+		{
+			Path:     "drives.layout[0].partitions[1].size",
+			GoField:  "Drives.Layout[0].Partitions[1].Size",
+			Messages: []string{"must be greater than 0"},
+		},
+		{
+			Path:     "drives.layout[0].slot_positions[1]",
+			GoField:  "Drives.Layout[0].SlotPositions[1]",
+			Messages: []string{"must refer to a slot position"},
+		},
+		{
+			Path:     "hosts",
+			GoField:  "Hosts",
+			Messages: []string{"can't be empty"},
+		},
+	}))
+
+	input.Drives.Layout[0].SlotPositions = []int{0, 1}
+	input.Drives.Layout[0].Partitions[1].Fill = true
+	input.Hosts = []DedicatedServerHostInput{{Hostname: "example-host"}}
+
+	g.Expect(input.Validate()).To(BeNil())
+
+	input.Hosts[0].Hostname = "-bad_host"
+
+	err := input.Validate()
+	g.Expect(err).To(MatchError("Input validation error: hosts[0].hostname: is not a valid hostname"))
+}
+
+func TestInputsValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(validationErrors(NetworkInput{Mask: 33, DistributionMethod: "bridge"}.Validate())).To(Equal([]FieldError{
+		{Path: "distribution_method", GoField: "DistributionMethod", Messages: []string{"must be one of: route, gateway"}},
+		{Path: "mask", GoField: "Mask", Messages: []string{"must be between 1 and 32"}},
+	}))
+	g.Expect(NetworkInput{Mask: 29}.Validate()).To(BeNil())
+
+	g.Expect(validationErrors(L2SegmentMemberInput{ID: "a", Mode: "access"}.Validate())).To(Equal([]FieldError{
+		{Path: "mode", GoField: "Mode", Messages: []string{"must be one of: native, trunk"}},
+	}))
+
+	g.Expect(validationErrors(PTRRecordCreateInput{IP: "10.0.0.1", Domain: "bad domain"}.Validate())).To(Equal([]FieldError{
+		{Path: "domain", GoField: "Domain", Messages: []string{"is not a valid hostname"}},
+	}))
+	g.Expect(PTRRecordCreateInput{IP: "10.0.0.1", Domain: "ai.privateservergrid.com"}.Validate()).To(BeNil())
+
+	g.Expect(validationErrors(ScheduleReleaseInput{ReleaseAfter: "tomorrow"}.Validate())).To(HaveLen(1))
+	g.Expect(ScheduleReleaseInput{ReleaseAfter: "2022-05-24T12:48:00+03:00"}.Validate()).To(BeNil())
+}
+
+func TestLoadBalancerInputsValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	l4 := L4LoadBalancerCreateInput{
+		Name:       "balancer",
+		LocationID: 1,
+		VHostZones: []L4VHostZoneInput{
+			{ID: "vhost", Ports: []int32{80, 0, 70000}, UpstreamID: "upstream"},
+		},
+		UpstreamZones: []L4UpstreamZoneInput{
+			{ID: "upstream", Upstreams: []L4UpstreamInput{{IP: "10.0.0.1", Port: 80}}},
+		},
+	}
+
+	g.Expect(validationErrors(l4.Validate())).To(Equal([]FieldError{
+		{Path: "vhost_zones[0].ports[1]", GoField: "VHostZones[0].Ports[1]", Messages: []string{"must be between 1 and 65535"}},
+		{Path: "vhost_zones[0].ports[2]", GoField: "VHostZones[0].Ports[2]", Messages: []string{"must be between 1 and 65535"}},
+	}))
+
+	l7 := L7LoadBalancerCreateInput{
+		Name:       "balancer",
+		LocationID: 1,
+		VHostZones: []L7VHostZoneInput{
+			{
+				ID:            "vhost",
+				Ports:         []int32{443},
+				SSL:           true,
+				SSLCertID:     "cert",
+				Domains:       []string{"example.com"},
+				LocationZones: []L7LocationZoneInput{{Location: "/", UpstreamID: "missing"}},
+			},
+		},
+		UpstreamZones: []L7UpstreamZoneInput{
+			{ID: "upstream", Upstreams: []L7UpstreamInput{{IP: "10.0.0.1", Port: 80}}},
+		},
+	}
+
+	g.Expect(validationErrors(l7.Validate())).To(Equal([]FieldError{
+		{
+			Path:     "vhost_zones[0].location_zones[0].upstream_id",
+			GoField:  "VHostZones[0].LocationZones[0].UpstreamID",
+			Messages: []string{"must match an upstream zone id"},
+		},
+	}))
+
+	l7.VHostZones[0].LocationZones[0].UpstreamID = "upstream"
+
+	g.Expect(l7.Validate()).To(BeNil())
+}
+
+func TestClientWithInputValidation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	calls := 0
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+
+		return nil, errors.New("unexpected request")
+	})
+
+	client := NewClientWithOptions("token", WithTransport(transport), WithInputValidation())
+
+	_, err := client.SSHKeys.Create(context.TODO(), SSHKeyCreateInput{Name: "key"})
+	g.Expect(err).To(BeAssignableToTypeOf(&ValidationError{}))
+	g.Expect(validationErrors(err)).To(Equal([]FieldError{
+		{Path: "public_key", GoField: "PublicKey", Messages: []string{"can't be blank"}},
+	}))
+	g.Expect(calls).To(Equal(0))
+
+	client = NewClientWithOptions("token", WithTransport(transport))
+
+	_, err = client.SSHKeys.Create(context.TODO(), SSHKeyCreateInput{Name: "key"})
+	g.Expect(err).NotTo(BeAssignableToTypeOf(&ValidationError{}))
+	g.Expect(calls).To(Equal(1))
+}
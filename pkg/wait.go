@@ -0,0 +1,306 @@
+package serverscom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	defaultWaitInterval    = 2 * time.Second
+	defaultWaitMaxInterval = 30 * time.Second
+)
+
+// Clock provides time for waiters, it can be replaced in tests to avoid real delays
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// WaitOptions configures polling of Wait and waiters, zero values mean defaults.
+//
+// A timeout is set by the context, waiting stops as soon as the context is done.
+type WaitOptions struct {
+	// Interval is a delay before the second poll, by default: 2s, it doubles after every poll
+	Interval time.Duration
+
+	// MaxInterval caps the delay between polls, by default: 30s
+	MaxInterval time.Duration
+
+	// FailureStatuses are added to terminal failure statuses of a waiter, Wait ignores them
+	FailureStatuses []string
+
+	// OnProgress is called after every poll which doesn't finish waiting
+	OnProgress func(WaitProgress)
+
+	// Clock is used to measure elapsed time and delays, by default: the system clock
+	Clock Clock
+}
+
+// WaitProgress describes the state of waiting after a poll
+type WaitProgress struct {
+	Attempt   int
+	Elapsed   time.Duration
+	Status    string
+	NextDelay time.Duration
+}
+
+// WaitCondition describes states of a resource polled by Wait
+type WaitCondition[T any] struct {
+	// Ready reports whether the resource reached the desired state, it's required
+	Ready func(T) bool
+
+	// Failed returns an error if the resource reached a terminal failure state
+	Failed func(T) error
+
+	// Status describes the state of the resource for progress and errors
+	Status func(T) string
+}
+
+// WaitFailedError is returned by waiters when a resource reaches a terminal failure status
+type WaitFailedError struct {
+	Status string
+}
+
+// Error returns error message
+func (e *WaitFailedError) Error() string {
+	return fmt.Sprintf("Resource reached failure status: %s", e.Status)
+}
+
+// WaitTimeoutError is returned when the context is done before a resource reaches the desired state
+type WaitTimeoutError struct {
+	Attempts int
+	Status   string
+	Err      error
+}
+
+// Error returns error message
+func (e *WaitTimeoutError) Error() string {
+	return fmt.Sprintf("Stopped waiting, attempts: %d, last status: %s: %s", e.Attempts, e.Status, e.Err)
+}
+
+// Unwrap returns the context error or the error of the poll interrupted by the context
+func (e *WaitTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Wait polls the resource until cond.Ready returns true and returns the last polled value.
+//
+// An error of poll or cond.Failed stops waiting and is returned as is, transient API errors
+// are retried by the client according to its RetryPolicy. When the context is done a
+// WaitTimeoutError with the context error is returned, a poll failed because of the done
+// context or refused because it would exceed the context deadline, e.g. by the client rate
+// limiter, is wrapped in WaitTimeoutError too. cond.Ready is required.
+//
+//	server, err := Wait(ctx, func(ctx context.Context) (*DedicatedServer, error) {
+//		return client.Hosts.GetDedicatedServer(ctx, id)
+//	}, WaitCondition[*DedicatedServer]{
+//		Ready: func(s *DedicatedServer) bool { return s.Status == "active" },
+//	}, nil)
+func Wait[T any](ctx context.Context, poll func(ctx context.Context) (T, error), cond WaitCondition[T], opts *WaitOptions) (T, error) {
+	if cond.Ready == nil {
+		var zero T
+
+		return zero, errors.New("Wait condition has no Ready function")
+	}
+
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	clock := opts.Clock
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	delay := opts.Interval
+	if delay <= 0 {
+		delay = defaultWaitInterval
+	}
+
+	maxDelay := opts.MaxInterval
+	if maxDelay <= 0 {
+		maxDelay = defaultWaitMaxInterval
+	}
+
+	startedAt := clock.Now()
+
+	var status string
+
+	for attempt := 1; ; attempt++ {
+		value, err := poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil || deadlineExceeded(ctx, err) {
+				return value, &WaitTimeoutError{Attempts: attempt, Status: status, Err: err}
+			}
+
+			return value, err
+		}
+
+		if cond.Ready(value) {
+			return value, nil
+		}
+
+		if cond.Failed != nil {
+			if err := cond.Failed(value); err != nil {
+				return value, err
+			}
+		}
+
+		if cond.Status != nil {
+			status = cond.Status(value)
+		}
+
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+
+		if opts.OnProgress != nil {
+			opts.OnProgress(WaitProgress{
+				Attempt:   attempt,
+				Elapsed:   clock.Now().Sub(startedAt),
+				Status:    status,
+				NextDelay: delay,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return value, &WaitTimeoutError{Attempts: attempt, Status: status, Err: ctx.Err()}
+		case <-clock.After(delay):
+		}
+
+		delay *= 2
+	}
+}
+
+// deadlineExceeded reports whether the poll error is caused by the deadline of the context,
+// which may be reported before the deadline passes
+func deadlineExceeded(ctx context.Context, err error) bool {
+	_, ok := ctx.Deadline()
+
+	return ok && errors.Is(err, context.DeadlineExceeded)
+}
+
+// statusCondition returns a condition which is ready when the status equals to one of ready
+// statuses and fails on one of failure statuses, statuses are compared case-insensitively
+func statusCondition[T any](status func(T) string, ready []string, failure []string) WaitCondition[T] {
+	matches := func(statuses []string, value string) bool {
+		return slices.ContainsFunc(statuses, func(s string) bool { return strings.EqualFold(s, value) })
+	}
+
+	return WaitCondition[T]{
+		Ready: func(value T) bool {
+			return matches(ready, status(value))
+		},
+		Failed: func(value T) error {
+			if matches(failure, status(value)) {
+				return &WaitFailedError{Status: status(value)}
+			}
+
+			return nil
+		},
+		Status: status,
+	}
+}
+
+// failureStatuses returns default failure statuses of a waiter with statuses from options
+func failureStatuses(opts *WaitOptions, defaults ...string) []string {
+	if opts == nil {
+		return defaults
+	}
+
+	return append(defaults, opts.FailureStatuses...)
+}
+
+// WaitDedicatedServerActive waits until the dedicated server status is active
+func WaitDedicatedServerActive(ctx context.Context, client *Client, id string, opts *WaitOptions) (*DedicatedServer, error) {
+	return Wait(ctx, func(ctx context.Context) (*DedicatedServer, error) {
+		return client.Hosts.GetDedicatedServer(ctx, id)
+	}, statusCondition(func(s *DedicatedServer) string {
+		return s.Status
+	}, []string{"active"}, failureStatuses(opts)), opts)
+}
+
+// WaitPowerStatus waits until the dedicated server power status equals to powerStatus, e.g. powered_on
+func WaitPowerStatus(ctx context.Context, client *Client, id string, powerStatus string, opts *WaitOptions) (*DedicatedServer, error) {
+	return Wait(ctx, func(ctx context.Context) (*DedicatedServer, error) {
+		return client.Hosts.GetDedicatedServer(ctx, id)
+	}, statusCondition(func(s *DedicatedServer) string {
+		return s.PowerStatus
+	}, []string{powerStatus}, failureStatuses(opts)), opts)
+}
+
+// WaitCloudInstanceStatus waits until the cloud instance status equals to status, e.g. ACTIVE,
+// it fails when the instance reaches ERROR status
+func WaitCloudInstanceStatus(ctx context.Context, client *Client, id string, status string, opts *WaitOptions) (*CloudComputingInstance, error) {
+	return Wait(ctx, func(ctx context.Context) (*CloudComputingInstance, error) {
+		return client.CloudComputingInstances.Get(ctx, id)
+	}, statusCondition(func(i *CloudComputingInstance) string {
+		return i.Status
+	}, []string{status}, failureStatuses(opts, "ERROR")), opts)
+}
+
+// WaitVolumeAttached waits until the cloud volume is attached to the instance, an empty
+// instanceID means any instance. It fails when the volume reaches an error status.
+func WaitVolumeAttached(ctx context.Context, client *Client, volumeID, instanceID string, opts *WaitOptions) (*CloudBlockStorageVolume, error) {
+	failures := failureStatuses(opts, "error", "error_attaching")
+
+	return Wait(ctx, func(ctx context.Context) (*CloudBlockStorageVolume, error) {
+		return client.CloudBlockStorageVolumes.Get(ctx, volumeID)
+	}, WaitCondition[*CloudBlockStorageVolume]{
+		Ready: func(v *CloudBlockStorageVolume) bool {
+			return slices.ContainsFunc(v.Attachments, func(a Attachment) bool {
+				return instanceID == "" || a.InstanceID == instanceID
+			})
+		},
+		Failed: statusCondition(func(v *CloudBlockStorageVolume) string {
+			return v.Status
+		}, nil, failures).Failed,
+		Status: func(v *CloudBlockStorageVolume) string {
+			return v.Status
+		},
+	}, opts)
+}
+
+// WaitL7LoadBalancerActive waits until the l7 load balancer status is active
+func WaitL7LoadBalancerActive(ctx context.Context, client *Client, id string, opts *WaitOptions) (*L7LoadBalancer, error) {
+	return Wait(ctx, func(ctx context.Context) (*L7LoadBalancer, error) {
+		return client.LoadBalancers.GetL7LoadBalancer(ctx, id)
+	}, statusCondition(func(lb *L7LoadBalancer) string {
+		return lb.Status
+	}, []string{"active"}, failureStatuses(opts)), opts)
+}
+
+// WaitFeatureStatus waits until the feature of the dedicated server has the status, e.g. activated
+func WaitFeatureStatus(ctx context.Context, client *Client, serverID, feature, status string, opts *WaitOptions) (*DedicatedServerFeature, error) {
+	return Wait(ctx, func(ctx context.Context) (*DedicatedServerFeature, error) {
+		features, err := client.Hosts.DedicatedServerFeatures(serverID).Collect(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range features {
+			if features[i].Name == feature {
+				return &features[i], nil
+			}
+		}
+
+		return nil, fmt.Errorf("Feature %s not found for dedicated server %s", feature, serverID)
+	}, statusCondition(func(f *DedicatedServerFeature) string {
+		return f.Status
+	}, []string{status}, failureStatuses(opts)), opts)
+}
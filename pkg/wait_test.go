@@ -0,0 +1,242 @@
+package serverscom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeClock advances its time by a delay instead of sleeping, a stopped clock never fires
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	delays  []time.Duration
+	stopped bool
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	c.delays = append(c.delays, d)
+
+	ch := make(chan time.Time, 1)
+	if !c.stopped {
+		ch <- c.now
+	}
+
+	return ch
+}
+
+func TestWaitDedicatedServerActive(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers/a").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"id": "a", "status": "init"}`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts/dedicated_servers/a").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"id": "a", "status": "pending"}`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts/dedicated_servers/a").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"id": "a", "status": "pending"}`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts/dedicated_servers/a").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"id": "a", "status": "active"}`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	clock := &fakeClock{now: time.Now()}
+
+	var progress []WaitProgress
+
+	server, err := WaitDedicatedServerActive(context.TODO(), client, "a", &WaitOptions{
+		Interval:    time.Second,
+		MaxInterval: 3 * time.Second,
+		Clock:       clock,
+		OnProgress:  func(p WaitProgress) { progress = append(progress, p) },
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(server.Status).To(Equal("active"))
+	g.Expect(clock.delays).To(Equal([]time.Duration{time.Second, 2 * time.Second, 3 * time.Second}))
+	g.Expect(progress).To(Equal([]WaitProgress{
+		{Attempt: 1, Elapsed: 0, Status: "init", NextDelay: time.Second},
+		{Attempt: 2, Elapsed: time.Second, Status: "pending", NextDelay: 2 * time.Second},
+		{Attempt: 3, Elapsed: 3 * time.Second, Status: "pending", NextDelay: 3 * time.Second},
+	}))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestWaitCloudInstanceStatusFailure(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/cloud_computing/instances/a").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"id": "a", "status": "BUILD"}`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/cloud_computing/instances/a").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"id": "a", "status": "ERROR"}`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	instance, err := WaitCloudInstanceStatus(context.TODO(), client, "a", "ACTIVE", &WaitOptions{Clock: &fakeClock{}})
+	g.Expect(err).To(Equal(&WaitFailedError{Status: "ERROR"}))
+	g.Expect(instance.Status).To(Equal("ERROR"))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestWaitVolumeAttached(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/cloud_block_storage/volumes/a").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"id": "a", "status": "attaching", "attachments": []}`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/cloud_block_storage/volumes/a").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`{"id": "a", "status": "in-use", "attachments": [{"id": "x", "instance_id": "b"}]}`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	volume, err := WaitVolumeAttached(context.TODO(), client, "a", "b", &WaitOptions{Clock: &fakeClock{}})
+	g.Expect(err).To(BeNil())
+	g.Expect(volume.Attachments[0].InstanceID).To(Equal("b"))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestWaitFeatureStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ts, client := newFakeServer().
+		WithRequestPath("/hosts/dedicated_servers/a/features").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`[{"name": "oob_public_access", "status": "activation"}]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts/dedicated_servers/a/features").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`[{"name": "oob_public_access", "status": "activated"}]`).
+		WithResponseCode(200).
+		Next().
+		WithRequestPath("/hosts/dedicated_servers/a/features").
+		WithRequestMethod("GET").
+		WithResponseBodyStubInline(`[]`).
+		WithResponseCode(200).
+		Build()
+
+	defer ts.Close()
+
+	ctx := context.TODO()
+	opts := &WaitOptions{Clock: &fakeClock{}}
+
+	feature, err := WaitFeatureStatus(ctx, client, "a", "oob_public_access", "activated", opts)
+	g.Expect(err).To(BeNil())
+	g.Expect(feature.Status).To(Equal("activated"))
+
+	_, err = WaitFeatureStatus(ctx, client, "a", "no_private_ip", "activated", opts)
+	g.Expect(err).To(MatchError("Feature no_private_ip not found for dedicated server a"))
+	g.Expect(ts.Requests).To(BeEmpty())
+}
+
+func TestWaitTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	polls := 0
+
+	_, err := Wait(ctx, func(ctx context.Context) (string, error) {
+		polls++
+		cancel()
+
+		return "pending", nil
+	}, WaitCondition[string]{
+		Ready:  func(s string) bool { return s == "active" },
+		Status: func(s string) string { return s },
+	}, &WaitOptions{Clock: &fakeClock{stopped: true}})
+
+	var timeoutErr *WaitTimeoutError
+
+	g.Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+	g.Expect(timeoutErr.Attempts).To(Equal(1))
+	g.Expect(polls).To(Equal(1))
+	g.Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	g.Expect(err).To(MatchError("Stopped waiting, attempts: 1, last status: pending: context canceled"))
+}
+
+func TestWaitTimeoutDuringPoll(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	polls := 0
+
+	_, err := Wait(ctx, func(ctx context.Context) (string, error) {
+		polls++
+
+		if polls == 2 {
+			cancel()
+
+			return "", fmt.Errorf("Get server: %w", ctx.Err())
+		}
+
+		return "pending", nil
+	}, WaitCondition[string]{
+		Ready:  func(s string) bool { return s == "active" },
+		Status: func(s string) string { return s },
+	}, &WaitOptions{Clock: &fakeClock{}})
+
+	var timeoutErr *WaitTimeoutError
+
+	g.Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+	g.Expect(timeoutErr.Attempts).To(Equal(2))
+	g.Expect(timeoutErr.Status).To(Equal("pending"))
+	g.Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	g.Expect(err).To(MatchError("Stopped waiting, attempts: 2, last status: pending: Get server: context canceled"))
+}
+
+func TestWaitWithoutReady(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	polls := 0
+
+	_, err := Wait(context.TODO(), func(ctx context.Context) (string, error) {
+		polls++
+
+		return "pending", nil
+	}, WaitCondition[string]{}, nil)
+
+	g.Expect(err).To(MatchError("Wait condition has no Ready function"))
+	g.Expect(polls).To(BeZero())
+}